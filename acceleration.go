@@ -0,0 +1,258 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// Acceleration is a measurement of the rate of change of velocity stored as an
+// int64 nano Metre per Second squared.
+//
+// Like Force, it only represents the magnitude and not the direction.
+//
+// The highest representable value is 9.2Gm/s².
+type Acceleration int64
+
+// String returns the acceleration formatted as a string in m/s².
+func (a Acceleration) String() string {
+	return formatSI(int64(a), nano, siFormat{}) + "m/s²"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (a Acceleration) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(a), "nm/s²", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(a), nano, siPrecision(prec)), "m/s²"...)
+	})
+}
+
+// FormatUnit returns the acceleration formatted in one of the units accepted by
+// Set, "m/s²", "m/s^2", "m/s2", "g", "gn", "Gal", "ft/s²", "ft/s^2" or "ft/s2",
+// with an optional SI prefix, rounded to precision digits after the decimal
+// point. A negative precision uses as many digits as necessary to represent
+// the acceleration exactly.
+func (a Acceleration) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(a), symbol, precision,
+		scaledUnit{"ft/s²", int64(FootPerSecondSquared), 1},
+		scaledUnit{"ft/s^2", int64(FootPerSecondSquared), 1},
+		scaledUnit{"ft/s2", int64(FootPerSecondSquared), 1},
+		scaledUnit{"m/s²", int64(MetrePerSecondSquared), 1},
+		scaledUnit{"m/s^2", int64(MetrePerSecondSquared), 1},
+		scaledUnit{"m/s2", int64(MetrePerSecondSquared), 1},
+		scaledUnit{"gn", int64(StandardGravity), 1},
+		scaledUnit{"g", int64(StandardGravity), 1},
+		scaledUnit{"Gal", int64(Gal), 1},
+	)
+}
+
+// Set sets the Acceleration to the value represented by s. Units are to be
+// provided in "m/s²", "m/s^2" or "m/s2", "g" or "gn" (standard gravity), "Gal"
+// (gal, 1cm/s²) or "ft/s²", "ft/s^2" or "ft/s2" with an optional SI prefix:
+// "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T". A lone
+// "Gal" is gal and not the giga prefix.
+func (a *Acceleration) Set(s string) error {
+	d, n, err := atod(s)
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s[n:], accelerationUnits...); found != "" {
+					return err
+				}
+				return notNumberUnitErr("m/s², g, Gal or ft/s²")
+			case errOverflowsInt64:
+				return maxValueErr(maxAcceleration.String())
+			case errOverflowsInt64Negative:
+				return minValueErr(minAcceleration.String())
+			}
+		}
+		return err
+	}
+
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "m/s²", "m/s^2", "m/s2", "Gal":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
+	}
+
+	// factor is the value of the unit in nano metres per second squared and
+	// maxUnit is the largest representable value in the unit.
+	var factor decimal
+	var maxUnit string
+	switch s[n:] {
+	case "m/s²", "m/s^2", "m/s2":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minAcceleration.String())
+			}
+			return maxValueErr(maxAcceleration.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoMetrePerSecondSquared.String())
+		}
+		*a = (Acceleration)(v)
+		return nil
+	case "g", "gn":
+		factor, maxUnit = decimal{base: 980665, exp: 4}, "940522200g"
+	case "Gal":
+		factor, maxUnit = decimal{base: 1, exp: 7}, "922337203685Gal"
+	case "ft/s²", "ft/s^2", "ft/s2":
+		factor, maxUnit = decimal{base: 3048, exp: 5}, "30260406945ft/s²"
+	case "":
+		return noUnitErr("m/s², g, Gal or ft/s²")
+	default:
+		if found := hasSuffixes(s[n:], accelerationUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("m/s², g, Gal or ft/s²")
+	}
+	v, lossy, overflow := dtoiScaled(d, factor, si)
+	if lossy {
+		return errors.New("converting to nano metres per second squared would overflow, consider using nm/s² for maximum precision")
+	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + maxUnit)
+		}
+		return maxValueErr(maxUnit)
+	}
+	if roundsToZero(d, v) {
+		return resolutionErr(NanoMetrePerSecondSquared.String())
+	}
+	*a = (Acceleration)(v)
+	return nil
+}
+
+// accelerationUnits are the units accepted by Acceleration.Set.
+var accelerationUnits = []string{"m/s²", "m/s^2", "m/s2", "ft/s²", "ft/s^2", "ft/s2", "gn", "g", "Gal"}
+
+// MarshalJSON implements json.Marshaler. The Acceleration is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (a Acceleration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano metres per second
+// squared.
+func (a *Acceleration) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, a.Set, func(v int64) error {
+		*a = Acceleration(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Acceleration to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (a Acceleration) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(a), nano, siFormat{mode: siExact}), "m/s²"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Acceleration is encoded as
+// appended by AppendText.
+func (a Acceleration) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (a *Acceleration) UnmarshalText(text []byte) error {
+	return a.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Acceleration is stored as an integer of
+// nano metres per second squared.
+func (a Acceleration) Value() (driver.Value, error) {
+	return int64(a), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano metres per
+// second squared or text in a format understood by Set.
+func (a *Acceleration) Scan(src any) error {
+	return scanValue(src, a.Set, func(v int64) error {
+		*a = Acceleration(v)
+		return nil
+	})
+}
+
+// G returns the acceleration as a floating number of multiples of
+// StandardGravity.
+func (a Acceleration) G() float64 {
+	return float64(a) / float64(StandardGravity)
+}
+
+// Mul returns the force needed to give the mass m the acceleration a, m×a.
+//
+// It is the same as Weight, so KiloGram.Mul(StandardGravity) is EarthGravity.
+// The result saturates at the highest representable Force.
+func (m Mass) Mul(a Acceleration) Force {
+	return Weight(m, a)
+}
+
+// Div returns the constant acceleration that changes the speed by sp over the
+// duration d, sp/d.
+//
+// A 0s duration returns a 0m/s² acceleration. An acceleration that cannot be
+// represented saturates.
+func (sp Speed) Div(d time.Duration) Acceleration {
+	if d == 0 {
+		return 0
+	}
+	if d < 0 {
+		sp, d = -sp, -d
+	}
+	v, overflow := mulDiv(int64(sp), int64(time.Second), int64(d))
+	if overflow {
+		if sp < 0 {
+			return minAcceleration
+		}
+		return maxAcceleration
+	}
+	return Acceleration(v)
+}
+
+const (
+	NanoMetrePerSecondSquared  Acceleration = 1
+	MicroMetrePerSecondSquared Acceleration = 1000 * NanoMetrePerSecondSquared
+	MilliMetrePerSecondSquared Acceleration = 1000 * MicroMetrePerSecondSquared
+	// MetrePerSecondSquared is m/s².
+	MetrePerSecondSquared     Acceleration = 1000 * MilliMetrePerSecondSquared
+	KiloMetrePerSecondSquared Acceleration = 1000 * MetrePerSecondSquared
+	MegaMetrePerSecondSquared Acceleration = 1000 * KiloMetrePerSecondSquared
+	GigaMetrePerSecondSquared Acceleration = 1000 * MegaMetrePerSecondSquared
+
+	// StandardGravity is the nominal acceleration due to gravity at the
+	// Earth's surface, 1g. A kilogram under StandardGravity weighs
+	// EarthGravity.
+	StandardGravity Acceleration = 9806650 * MicroMetrePerSecondSquared
+
+	// Gal is the CGS unit of acceleration, 1cm/s², used in gravimetry.
+	MilliGal Acceleration = 10 * MicroMetrePerSecondSquared
+	Gal      Acceleration = 1000 * MilliGal
+
+	FootPerSecondSquared Acceleration = 304800 * MicroMetrePerSecondSquared
+
+	maxAcceleration Acceleration = (1 << 63) - 1
+	minAcceleration Acceleration = -((1 << 63) - 1)
+)
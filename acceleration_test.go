@@ -0,0 +1,213 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAcceleration_String(t *testing.T) {
+	if s := NanoMetrePerSecondSquared.String(); s != "1nm/s²" {
+		t.Fatalf("%v", s)
+	}
+	if s := MetrePerSecondSquared.String(); s != "1m/s²" {
+		t.Fatalf("%v", s)
+	}
+	if s := GigaMetrePerSecondSquared.String(); s != "1Gm/s²" {
+		t.Fatalf("%v", s)
+	}
+	if s := StandardGravity.String(); s != "9.807m/s²" {
+		t.Fatalf("%v", s)
+	}
+	if s := Gal.String(); s != "10mm/s²" {
+		t.Fatalf("%v", s)
+	}
+	if s := FootPerSecondSquared.String(); s != "304.800mm/s²" {
+		t.Fatalf("%v", s)
+	}
+}
+
+func TestAcceleration_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected Acceleration
+	}{
+		{"1nm/s²", 1 * NanoMetrePerSecondSquared},
+		{"1um/s²", 1 * MicroMetrePerSecondSquared},
+		{"1µm/s²", 1 * MicroMetrePerSecondSquared},
+		{"1mm/s²", 1 * MilliMetrePerSecondSquared},
+		{"1m/s²", 1 * MetrePerSecondSquared},
+		{"1m/s^2", 1 * MetrePerSecondSquared},
+		{"1m/s2", 1 * MetrePerSecondSquared},
+		{"1km/s²", 1 * KiloMetrePerSecondSquared},
+		{"1Mm/s²", 1 * MegaMetrePerSecondSquared},
+		{"1Gm/s²", 1 * GigaMetrePerSecondSquared},
+		{"-9.81m/s²", -9810 * MilliMetrePerSecondSquared},
+		{"9.223372036854775807Gm/s²", maxAcceleration},
+		{"-9.223372036854775807Gm/s²", minAcceleration},
+		{"1g", StandardGravity},
+		{"1gn", StandardGravity},
+		{"-2g", -2 * StandardGravity},
+		{"1mg", 9806650 * NanoMetrePerSecondSquared},
+		{"16kg", 16000 * StandardGravity},
+		{"1Gal", Gal},
+		{"1mGal", MilliGal},
+		{"980.665Gal", StandardGravity},
+		{"1ft/s²", FootPerSecondSquared},
+		{"1ft/s^2", FootPerSecondSquared},
+		{"32.174ft/s2", 9806635200 * NanoMetrePerSecondSquared},
+		{"940522200g", 9223372032630000000},
+		{"922337203685Gal", 9223372036850000000},
+		{"30260406945ft/s²", 9223372036836000000},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10Gm/s²",
+			"maximum value is 9.223Gm/s²",
+		},
+		{
+			"-10Gm/s²",
+			"minimum value is -9.223Gm/s²",
+		},
+		{
+			"10Em/s²",
+			"unknown unit prefix; valid prefixes for \"m/s²\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10Eg",
+			"unknown unit prefix; valid prefixes for \"g\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"940522201g",
+			"maximum value is 940522200g",
+		},
+		{
+			"-1Gg",
+			"minimum value is -940522200g",
+		},
+		{
+			"922337203686Gal",
+			"maximum value is 922337203685Gal",
+		},
+		{
+			"30260406946ft/s²",
+			"maximum value is 30260406945ft/s²",
+		},
+		{
+			"10",
+			"no unit provided; need m/s², g, Gal or ft/s²",
+		},
+		{
+			"1random",
+			"unknown unit provided; need m/s², g, Gal or ft/s²",
+		},
+		{
+			"9223372036854775808",
+			"maximum value is 9.223Gm/s²",
+		},
+		{
+			"-9223372036854775808",
+			"minimum value is -9.223Gm/s²",
+		},
+		{
+			"g",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit m/s², g, Gal or ft/s²",
+		},
+		{
+			"++1g",
+			"contains multiple plus symbols",
+		},
+		{
+			"1.1.1.1g",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got Acceleration
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: Acceleration.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: Acceleration.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got Acceleration
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: Acceleration.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestAcceleration_RoundTrip(t *testing.T) {
+	x := 123 * MilliMetrePerSecondSquared
+	var y Acceleration
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("Acceleration.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("Acceleration expected %s to equal %s", x, y)
+	}
+}
+
+func TestAcceleration_G(t *testing.T) {
+	if v := Acceleration(-3 * StandardGravity).G(); v != -3. {
+		t.Fatal(v)
+	}
+}
+
+func TestMass_Mul(t *testing.T) {
+	data := []struct {
+		m        Mass
+		a        Acceleration
+		expected Force
+	}{
+		{KiloGram, StandardGravity, EarthGravity},
+		{KiloGram, MetrePerSecondSquared, Newton},
+		{2 * KiloGram, -3 * MetrePerSecondSquared, -6 * Newton},
+		{-Gram, StandardGravity, -GramForce},
+		{GigaGram, GigaMetrePerSecondSquared, maxForce},
+		{-GigaGram, GigaMetrePerSecondSquared, minForce},
+	}
+	for i, line := range data {
+		if v := line.m.Mul(line.a); v != line.expected {
+			t.Fatalf("%d: %s.Mul(%s) = %s != %s", i, line.m, line.a, v, line.expected)
+		}
+	}
+}
+
+func TestSpeed_Div(t *testing.T) {
+	data := []struct {
+		sp       Speed
+		d        time.Duration
+		expected Acceleration
+	}{
+		{10 * MetrePerSecond, time.Second, 10 * MetrePerSecondSquared},
+		{100 * KilometrePerHour, 2700 * time.Millisecond, 10288065852 * NanoMetrePerSecondSquared},
+		{-MetrePerSecond, time.Millisecond, -KiloMetrePerSecondSquared},
+		{MetrePerSecond, -time.Second, -MetrePerSecondSquared},
+		{MetrePerSecond, 0, 0},
+		{GigaMetrePerSecond, time.Nanosecond, maxAcceleration},
+		{-GigaMetrePerSecond, time.Nanosecond, minAcceleration},
+	}
+	for i, line := range data {
+		if v := line.sp.Div(line.d); v != line.expected {
+			t.Fatalf("%d: %s.Div(%s) = %s != %s", i, line.sp, line.d, v, line.expected)
+		}
+	}
+}
@@ -7,8 +7,11 @@
 package unit
 
 import (
+	"database/sql/driver"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -22,58 +25,116 @@ type Angle int64
 
 // String returns the angle formatted as a string in degree.
 func (a Angle) String() string {
+	var buf [32]byte
+	return string(appendAngle(buf[:0], a))
+}
+
+// appendAngle appends the angle to b as formatted by String.
+func appendAngle(b []byte, a Angle) []byte {
 	// Angle is not a S.I. unit, so it must not be prefixed by S.I. prefixes.
 	if a == 0 {
-		return "0°"
+		return append(b, "0°"...)
 	}
 	// Round.
-	prefix := ""
 	if a < 0 {
 		a = -a
-		prefix = "-"
+		b = append(b, '-')
 	}
 	switch {
 	case a < Degree:
 		v := ((a * 1000) + Degree/2) / Degree
-		return prefix + "0." + prefixZeros(3, int(v)) + "°"
+		b = append(b, "0."...)
+		b = appendPrefixZeros(b, 3, int(v))
 	case a < 10*Degree:
 		v := ((a * 1000) + Degree/2) / Degree
 		i := v / 1000
 		v = v - i*1000
-		return prefix + strconv.FormatInt(int64(i), 10) + "." + prefixZeros(3, int(v)) + "°"
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, '.')
+		b = appendPrefixZeros(b, 3, int(v))
 	case a < 100*Degree:
 		v := ((a * 1000) + Degree/2) / Degree
 		i := v / 1000
 		v = v - i*1000
-		return prefix + strconv.FormatInt(int64(i), 10) + "." + prefixZeros(2, int(v)) + "°"
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, '.')
+		b = appendPrefixZeros(b, 2, int(v))
 	case a < 1000*Degree:
 		v := ((a * 1000) + Degree/2) / Degree
 		i := v / 1000
 		v = v - i*1000
-		return prefix + strconv.FormatInt(int64(i), 10) + "." + prefixZeros(1, int(v)) + "°"
+		b = strconv.AppendInt(b, int64(i), 10)
+		b = append(b, '.')
+		b = appendPrefixZeros(b, 1, int(v))
 	case a > maxAngle-Degree:
 		u := (uint64(a) + uint64(Degree)/2) / uint64(Degree)
-		v := int64(u)
-		return prefix + strconv.FormatInt(int64(v), 10) + "°"
+		b = strconv.AppendInt(b, int64(u), 10)
 	default:
 		v := (a + Degree/2) / Degree
-		return prefix + strconv.FormatInt(int64(v), 10) + "°"
+		b = strconv.AppendInt(b, int64(v), 10)
+	}
+	return append(b, "°"...)
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (a Angle) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(a), "nrad", func(b []byte, prec int) []byte {
+		if prec < 0 {
+			return appendAngle(b, a)
+		}
+		b = strconv.AppendFloat(b, float64(a)/float64(Degree), 'f', prec, 64)
+		return append(b, "°"...)
+	})
+}
+
+// FormatUnit returns the angle formatted in one of the units accepted by Set,
+// for example "°", "rad", "arcmin", "gon" or "mil", with an optional SI prefix,
+// rounded to precision digits after the decimal point. A negative precision
+// uses as many digits as necessary to represent the angle exactly.
+//
+// The symbol "DMS" formats the angle in degrees, minutes and seconds, as in
+// 45°30'15.2", with precision digits after the decimal point of the seconds.
+func (a Angle) FormatUnit(symbol string, precision int) (string, error) {
+	if symbol == "DMS" {
+		return a.formatDMS(precision)
 	}
+	return formatUnit(int64(a), symbol, precision,
+		scaledUnit{"°", int64(Degree), 1},
+		scaledUnit{"Deg", int64(Degree), 1},
+		scaledUnit{"deg", int64(Degree), 1},
+		scaledUnit{"Rad", int64(Radian), 1},
+		scaledUnit{"rad", int64(Radian), 1},
+		scaledUnit{"arcmin", int64(Degree), 60},
+		scaledUnit{"arcsec", int64(Degree), 3600},
+		scaledUnit{"gon", int64(Pi), 200},
+		scaledUnit{"grad", int64(Pi), 200},
+		scaledUnit{"turn", int64(Theta), 1},
+		scaledUnit{"mil", int64(Theta), 6400},
+	)
 }
 
 // Set sets the Angle to the value represented by s. Units are to be provided in
-// "rad", "deg" or "°" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// "rad", "deg" or "°", "arcmin" or "′", "arcsec" or "″", "gon" or "grad",
+// "turn" or "mil" (NATO mil, 1/6400 turn) with an optional SI prefix: "p", "n",
+// "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T".
+//
+// Degrees, minutes and seconds are also accepted, as in 45°30'15.2" or
+// 45°30′15.2″. A leading or trailing hemisphere letter "N", "S", "E" or "W"
+// may be given, as in N 45°30.5', where "S" and "W" are negative.
 func (a *Angle) Set(s string) error {
+	if isDMS(s) {
+		return a.setDMS(s)
+	}
 	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s[n:], "Rad", "rad", "Deg", "deg", "°"); found != "" {
+				if found := hasSuffixes(s[n:], angleUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("Rad, Deg or °")
+				return notNumberUnitErr("Rad, Deg, °, arcmin, arcsec, gon, turn or mil")
 			case errOverflowsInt64:
 				// TODO(maruel): Look for suffix, and reuse it.
 				return maxValueErr(maxAngle.String())
@@ -91,29 +152,21 @@ func (a *Angle) Set(s string) error {
 		if r <= 1 || rsize == 0 {
 			return errors.New("unexpected end of string")
 		}
-		var siSize int
-		si, siSize = parseSIPrefix(r)
-		n += siSize
+		switch s[n:] {
+		case "deg", "mil":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
 	}
 
+	// The units other than radians are num/den degrees or turns.
+	var num, den int64
 	switch s[n:] {
 	case "Deg", "deg", "°":
-		degreePerRadian := decimal{
-			base: 17453293,
-			exp:  0,
-			neg:  false,
-		}
-		deg, _ := decimalMul(d, degreePerRadian)
-		// Impossible for precision loss to exceed 9 since the number of
-		// significant figures in degrees per radian is only 8.
-		v, overflow := dtoi(deg, int(si))
-		if overflow {
-			if deg.neg {
-				return minValueErr(minAngle.String())
-			}
-			return maxValueErr(maxAngle.String())
-		}
-		*a = (Angle)(v)
+		num, den = int64(Degree), 1
 	case "Rad", "rad":
 		v, overflow := dtoi(d, int(si-nano))
 		if overflow {
@@ -122,18 +175,239 @@ func (a *Angle) Set(s string) error {
 			}
 			return maxValueErr("9.223G" + s[n:])
 		}
+		if roundsToZero(d, v) {
+			return resolutionErr("1nrad")
+		}
 		*a = (Angle)(v)
+		return nil
+	case "arcmin", "′", "'":
+		num, den = int64(Degree), 60
+	case "arcsec", "″", "\"":
+		num, den = int64(Degree), 3600
+	case "gon", "grad":
+		num, den = int64(Pi), 200
+	case "turn":
+		num, den = int64(Theta), 1
+	case "mil":
+		num, den = int64(Theta), 6400
 	case "":
-		return noUnitErr("Rad, Deg or °")
+		return noUnitErr("Rad, Deg, °, arcmin, arcsec, gon, turn or mil")
 	default:
-		if found := hasSuffixes(s[n:], "Rad", "rad", "Deg", "deg", "°"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], angleUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("Rad, Deg, °, arcmin, arcsec, gon, turn or mil")
+	}
+	x, _ := decimalMul(d, decimal{base: uint64(num)})
+	// Impossible for precision loss to exceed 9 since the number of
+	// significant figures in the factors is at most 10.
+	v, overflow := dtoi(x, int(si))
+	if overflow {
+		if x.neg {
+			return minValueErr(minAngle.String())
 		}
-		return incorrectUnitErr("Rad, Deg or °")
+		return maxValueErr(maxAngle.String())
+	}
+	// Round half away from zero from the remainder, as adding den/2 to v
+	// could overflow.
+	q, r := v/den, v%den
+	if r >= den-r {
+		q++
+	} else if -r >= den+r {
+		q--
+	}
+	v = q
+	if roundsToZero(x, v) {
+		return resolutionErr("1nrad")
 	}
+	*a = (Angle)(v)
 	return nil
 }
 
+// angleUnits are the units accepted by Angle.Set.
+var angleUnits = []string{"Rad", "rad", "Deg", "deg", "°", "arcmin", "′", "'", "arcsec", "″", "\"", "gon", "grad", "turn", "mil"}
+
+// isDMS reports whether s is an angle in degrees followed by minutes or
+// seconds, or has a hemisphere letter.
+func isDMS(s string) bool {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 {
+		return false
+	}
+	if strings.ContainsAny(s[:1], "NSEW") && strings.ContainsAny(s[1:2], " 0123456789") {
+		return true
+	}
+	if strings.ContainsAny(s[len(s)-1:], "NSEW") && hasSuffixes(s[:len(s)-1], " ", "°", "'", "′", "\"", "″") != "" {
+		return true
+	}
+	i := strings.Index(s, "°")
+	return i != -1 && strings.TrimSpace(s[i+len("°"):]) != ""
+}
+
+// setDMS parses the angles detected by isDMS.
+func (a *Angle) setDMS(s string) error {
+	s = strings.TrimSpace(s)
+	neg := false
+	if h := s[:1]; strings.ContainsAny(h, "NSEW") {
+		neg = h == "S" || h == "W"
+		s = strings.TrimSpace(s[1:])
+	} else if h := s[len(s)-1:]; strings.ContainsAny(h, "NSEW") {
+		neg = h == "S" || h == "W"
+		s = strings.TrimSpace(s[:len(s)-1])
+	} else if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	}
+	// total is the angle in nano arc seconds.
+	var total int64
+	seen := 0
+	for _, c := range []struct {
+		symbols []string
+		arcsec  int64
+		max     int64
+	}{
+		{[]string{"°"}, 3600, 0},
+		{[]string{"′", "'"}, 60, 60},
+		{[]string{"″", "\""}, 1, 60},
+	} {
+		i, symbol := -1, ""
+		for _, x := range c.symbols {
+			if j := strings.Index(s, x); j != -1 {
+				i, symbol = j, x
+			}
+		}
+		if i == -1 {
+			continue
+		}
+		number := strings.TrimSpace(s[:i])
+		d, n, err := atod(number)
+		if err != nil || n != len(number) || d.neg || number[0] == '+' {
+			return errors.New("invalid degrees, minutes and seconds")
+		}
+		v, overflow := dtoi(d, 9)
+		if overflow || (c.max != 0 && v >= c.max*1000000000) {
+			return errors.New("invalid degrees, minutes and seconds")
+		}
+		if v, overflow = mulDiv(v, c.arcsec, 1); overflow || total > maxInt64-v {
+			return maxValueErr(maxAngle.String())
+		}
+		total += v
+		seen++
+		s = strings.TrimSpace(s[i+len(symbol):])
+	}
+	if s != "" || seen == 0 {
+		return errors.New("invalid degrees, minutes and seconds")
+	}
+	v, overflow := mulDiv(total, int64(Degree), 3600000000000)
+	if overflow {
+		return maxValueErr(maxAngle.String())
+	}
+	if neg {
+		v = -v
+	}
+	*a = (Angle)(v)
+	return nil
+}
+
+// formatDMS formats the angle as degrees, minutes and seconds, with precision
+// digits after the decimal point of the seconds.
+func (a Angle) formatDMS(precision int) (string, error) {
+	if precision < 0 {
+		precision = 0
+	}
+	if precision > 9 {
+		precision = 9
+	}
+	// v is the angle in 10^-precision arc seconds.
+	v, overflow := mulDiv(int64(a), 3600*int64(powerOf10[precision]), int64(Degree))
+	if overflow {
+		return "", errors.New("angle is too large to be formatted in degrees, minutes and seconds")
+	}
+	var b []byte
+	if v < 0 {
+		b = append(b, '-')
+		v = -v
+	}
+	perSecond := int64(powerOf10[precision])
+	b = strconv.AppendInt(b, v/perSecond/3600, 10)
+	b = append(b, "°"...)
+	b = strconv.AppendInt(b, v/perSecond/60%60, 10)
+	b = append(b, '\'')
+	b = strconv.AppendInt(b, v/perSecond%60, 10)
+	if precision > 0 {
+		b = append(b, '.')
+		b = appendPrefixZeros(b, precision, int(v%perSecond))
+	}
+	return string(append(b, '"')), nil
+}
+
+// MarshalJSON implements json.Marshaler. The Angle is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (a Angle) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano radians.
+func (a *Angle) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, a.Set, func(v int64) error {
+		*a = Angle(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Angle to b
+// exactly in radians, without the rounding of String, so that Set parses the
+// text back to the same value. It does not allocate when b has enough capacity.
+func (a Angle) AppendText(b []byte) ([]byte, error) {
+	// A nano radian has no exact decimal representation in degrees.
+	return append(appendSI(b, int64(a), nano, siFormat{mode: siExact}), "rad"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Angle is encoded as
+// appended by AppendText.
+func (a Angle) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (a *Angle) UnmarshalText(text []byte) error {
+	return a.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Angle is stored as an integer of nano
+// radians.
+func (a Angle) Value() (driver.Value, error) {
+	return int64(a), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano radians or
+// text in a format understood by Set.
+func (a *Angle) Scan(src any) error {
+	return scanValue(src, a.Set, func(v int64) error {
+		*a = Angle(v)
+		return nil
+	})
+}
+
+// DMS returns the angle in whole degrees, whole minutes and seconds. Each part
+// has the sign of the angle.
+func (a Angle) DMS() (deg, min int64, sec float64) {
+	u := int64(a)
+	if u < 0 {
+		u = -u
+	}
+	deg = u / int64(Degree)
+	rem := u % int64(Degree) * 60
+	min = rem / int64(Degree)
+	sec = float64(rem%int64(Degree)*60) / float64(Degree)
+	if a < 0 {
+		return -deg, -min, -sec
+	}
+	return deg, min, sec
+}
+
 const (
 	NanoRadian  Angle = 1
 	MicroRadian Angle = 1000 * NanoRadian
@@ -145,6 +419,16 @@ const (
 	Pi     Angle = 3141592653 * NanoRadian
 	Degree Angle = 17453293 * NanoRadian
 
+	// ArcMinute and ArcSecond are 1/60 and 1/3600 of a Degree. They are
+	// rounded to the nearest nano radian.
+	ArcMinute Angle = 290888 * NanoRadian
+	ArcSecond Angle = 4848 * NanoRadian
+
+	// Gradian is 1/400 of a turn, also known as gon.
+	Gradian Angle = 15707963 * NanoRadian
+	// Mil is the NATO mil, 1/6400 of a turn.
+	Mil Angle = 981748 * NanoRadian
+
 	maxAngle Angle = 9223372036854775807
 	minAngle Angle = -9223372036854775807
 )
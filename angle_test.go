@@ -8,6 +8,7 @@ package unit
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -90,25 +91,61 @@ func TestAngle_Set(t *testing.T) {
 		{"1udeg", Degree / 1000000},
 	}
 
+	more := []struct {
+		in       string
+		expected Angle
+	}{
+		{"1arcmin", ArcMinute},
+		{"1′", ArcMinute},
+		{"30'", 8726647 * NanoRadian},
+		{"60arcmin", Degree},
+		{"1arcsec", ArcSecond},
+		{"1″", ArcSecond},
+		{"3600arcsec", Degree},
+		{"1gon", Gradian},
+		{"1grad", Gradian},
+		{"400gon", 6283185306 * NanoRadian},
+		{"1turn", Theta},
+		{"-0.5turn", -3141592654 * NanoRadian},
+		{"1mil", Mil},
+		{"6400mil", Theta},
+		{"1kmil", 981747704 * NanoRadian},
+		{"45°30'15.2\"", 794198523 * NanoRadian},
+		{"45°30′15.2″", 794198523 * NanoRadian},
+		{"45° 30' 15.2\"", 794198523 * NanoRadian},
+		{"-45°30'15.2\"", -794198523 * NanoRadian},
+		{"N 45°30.5'", 794270276 * NanoRadian},
+		{"S 45°30.5'", -794270276 * NanoRadian},
+		{"45°30.5'N", 794270276 * NanoRadian},
+		{"45°W", -785398185 * NanoRadian},
+		{"E 45°", 785398185 * NanoRadian},
+		{"0°0'15\"", 72722 * NanoRadian},
+	}
+	succeeds = append(succeeds, more...)
+
 	fails := []struct {
 		in  string
 		err string
 	}{
 		{
 			"10Erad",
-			"unknown unit prefix; valid prefixes for \"rad\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"rad\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"1narcsec",
+			"nonzero value rounds to zero; resolution is 1nrad",
 		},
 		{
 			"10Exarad",
-			"unknown unit prefix; valid prefixes for \"rad\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"rad\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eRadianE",
-			"unknown unit provided; need Rad, Deg or °",
+			"unknown unit provided; need Rad, Deg, °, arcmin, arcsec, gon, turn or mil",
 		},
 		{
 			"10",
-			"no unit provided; need Rad, Deg or °",
+			"no unit provided; need Rad, Deg, °, arcmin, arcsec, gon, turn or mil",
 		},
 		{
 			fmt.Sprintf("%dnrad", uint64(maxAngle)+1),
@@ -145,7 +182,7 @@ func TestAngle_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need Rad, Deg or °",
+			"unknown unit provided; need Rad, Deg, °, arcmin, arcsec, gon, turn or mil",
 		},
 		{
 			"rad",
@@ -153,7 +190,7 @@ func TestAngle_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit Rad, Deg or °",
+			"does not contain number or unit Rad, Deg, °, arcmin, arcsec, gon, turn or mil",
 		},
 		{
 			"++1rad",
@@ -177,6 +214,18 @@ func TestAngle_Set(t *testing.T) {
 		},
 	}
 
+	fails = append(fails, []struct {
+		in  string
+		err string
+	}{
+		{"45°60'", "invalid degrees, minutes and seconds"},
+		{"45°30'60\"", "invalid degrees, minutes and seconds"},
+		{"45°-30'", "invalid degrees, minutes and seconds"},
+		{"45°30'x", "invalid degrees, minutes and seconds"},
+		{"N 45°30'S", "invalid degrees, minutes and seconds"},
+		{"10Emil", "unknown unit prefix; valid prefixes for \"mil\" are p,n,u,µ,m,c,d,da,h,k,M,G or T"},
+	}...)
+
 	for i, tt := range succeeds {
 		var got Angle
 		if err := got.Set(tt.in); err != nil {
@@ -195,6 +244,55 @@ func TestAngle_Set(t *testing.T) {
 	}
 }
 
+func TestAngle_DMS(t *testing.T) {
+	data := []struct {
+		in       Angle
+		deg, min int64
+		sec      float64
+	}{
+		{0, 0, 0, 0},
+		{Degree, 1, 0, 0},
+		{90*Degree + 30*ArcMinute, 90, 29, 59.99865927879627},
+		{26179940 * NanoRadian, 1, 30, 0.0001031324002868685},
+		{-26179940 * NanoRadian, -1, -30, -0.0001031324002868685},
+		{-Degree / 4, 0, -14, -59.99994843379986},
+	}
+	for i, line := range data {
+		deg, min, sec := line.in.DMS()
+		if deg != line.deg || min != line.min || math.Abs(sec-line.sec) > 1e-9 {
+			t.Errorf("#%d: Angle(%d).DMS() = %d, %d, %g != %d, %d, %g", i, line.in, deg, min, sec, line.deg, line.min, line.sec)
+		}
+	}
+}
+
+func TestAngle_FormatUnit_DMS(t *testing.T) {
+	data := []struct {
+		in        Angle
+		precision int
+		expected  string
+	}{
+		{0, 0, "0°0'0\""},
+		{794198523 * NanoRadian, 1, "45°30'15.2\""},
+		{-794198523 * NanoRadian, 1, "-45°30'15.2\""},
+		{794198523 * NanoRadian, 0, "45°30'15\""},
+		{Degree - NanoRadian, 2, "1°0'0.00\""},
+		{Pi, -1, "180°0'0\""},
+	}
+	for i, line := range data {
+		v, err := line.in.FormatUnit("DMS", line.precision)
+		if err != nil || v != line.expected {
+			t.Errorf("#%d: Angle(%d).FormatUnit(DMS, %d) = %s, %v != %s", i, line.in, line.precision, v, err, line.expected)
+		}
+		var a Angle
+		if err := a.Set(v); err != nil {
+			t.Errorf("#%d: Angle.Set(%s) failed: %v", i, v, err)
+		}
+	}
+	if _, err := maxAngle.FormatUnit("DMS", 9); err == nil {
+		t.Error("expected error")
+	}
+}
+
 func TestAngle_RoundTrip(t *testing.T) {
 	x := 123 * Degree
 	var y Angle
@@ -0,0 +1,290 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+)
+
+// AngularVelocity is a measurement of the rate of rotation stored as an int64
+// nano radian per second.
+//
+// A negative angular velocity is valid and is a rotation in the opposite
+// direction.
+//
+// The highest representable value is 9.2Grad/s.
+type AngularVelocity int64
+
+// String returns the angular velocity formatted as a string in rad/s.
+func (w AngularVelocity) String() string {
+	return formatSI(int64(w), nano, siFormat{}) + "rad/s"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (w AngularVelocity) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(w), "nrad/s", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(w), nano, siPrecision(prec)), "rad/s"...)
+	})
+}
+
+// FormatUnit returns the angular velocity formatted in one of the units
+// accepted by Set, "rad/s", "°/s", "deg/s", "dps", "rpm" or "RPM", with an
+// optional SI prefix, rounded to precision digits after the decimal point. A
+// negative precision uses as many digits as necessary to represent the angular
+// velocity exactly.
+func (w AngularVelocity) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(w), symbol, precision,
+		scaledUnit{"rad/s", int64(RadianPerSecond), 1},
+		// Same conversion factors as Set.
+		scaledUnit{"°/s", 17453292519943296, 1000000000},
+		scaledUnit{"deg/s", 17453292519943296, 1000000000},
+		scaledUnit{"dps", 17453292519943296, 1000000000},
+		scaledUnit{"rpm", 10471975511965977, 100000000},
+		scaledUnit{"RPM", 10471975511965977, 100000000},
+	)
+}
+
+// Set sets the AngularVelocity to the value represented by s. Units are to be
+// provided in "rad/s", "°/s", "deg/s" or "dps" (degrees per second) or "rpm" or
+// "RPM" (revolutions per minute) with an optional SI prefix: "p", "n", "u",
+// "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T".
+func (w *AngularVelocity) Set(s string) error {
+	d, n, err := atod(s)
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s[n:], angularVelocityUnits...); found != "" {
+					return err
+				}
+				return notNumberUnitErr("rad/s, °/s, dps or rpm")
+			case errOverflowsInt64:
+				return maxValueErr(maxAngularVelocity.String())
+			case errOverflowsInt64Negative:
+				return minValueErr(minAngularVelocity.String())
+			}
+		}
+		return err
+	}
+
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "deg/s", "dps":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
+	}
+
+	// factor is the value of the unit in nano radians per second and maxUnit
+	// is the largest representable value in the unit.
+	var factor decimal
+	var maxUnit string
+	switch s[n:] {
+	case "rad/s":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minAngularVelocity.String())
+			}
+			return maxValueErr(maxAngularVelocity.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoRadianPerSecond.String())
+		}
+		*w = (AngularVelocity)(v)
+		return nil
+	case "°/s", "deg/s", "dps":
+		// 1°/s is π/180rad/s.
+		factor, maxUnit = decimal{base: 17453292519943296, exp: -9}, "528460290544"+s[n:]
+	case "rpm", "RPM":
+		// 1rpm is 2π/60rad/s.
+		factor, maxUnit = decimal{base: 10471975511965977, exp: -8}, "88076715144"+s[n:]
+	case "":
+		return noUnitErr("rad/s, °/s, dps or rpm")
+	default:
+		if found := hasSuffixes(s[n:], angularVelocityUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("rad/s, °/s, dps or rpm")
+	}
+	v, lossy, overflow := dtoiScaled(d, factor, si)
+	if lossy {
+		return errors.New("converting to nano radians per second would overflow, consider using nrad/s for maximum precision")
+	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + maxUnit)
+		}
+		return maxValueErr(maxUnit)
+	}
+	if roundsToZero(d, v) {
+		return resolutionErr(NanoRadianPerSecond.String())
+	}
+	*w = (AngularVelocity)(v)
+	return nil
+}
+
+// angularVelocityUnits are the units accepted by AngularVelocity.Set.
+var angularVelocityUnits = []string{"rad/s", "°/s", "deg/s", "dps", "rpm", "RPM"}
+
+// MarshalJSON implements json.Marshaler. The AngularVelocity is encoded as a
+// JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (w AngularVelocity) MarshalJSON() ([]byte, error) {
+	return marshalJSON(w.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano radians per second.
+func (w *AngularVelocity) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, w.Set, func(v int64) error {
+		*w = AngularVelocity(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the AngularVelocity
+// to b exactly, without the rounding of String, so that Set parses the text
+// back to the same value. It does not allocate when b has enough capacity.
+func (w AngularVelocity) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(w), nano, siFormat{mode: siExact}), "rad/s"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The AngularVelocity is encoded
+// as appended by AppendText.
+func (w AngularVelocity) MarshalText() ([]byte, error) {
+	return w.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (w *AngularVelocity) UnmarshalText(text []byte) error {
+	return w.Set(string(text))
+}
+
+// Value implements driver.Valuer. The AngularVelocity is stored as an integer
+// of nano radians per second.
+func (w AngularVelocity) Value() (driver.Value, error) {
+	return int64(w), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano radians per
+// second or text in a format understood by Set.
+func (w *AngularVelocity) Scan(src any) error {
+	return scanValue(src, w.Set, func(v int64) error {
+		*w = AngularVelocity(v)
+		return nil
+	})
+}
+
+// DPS returns the angular velocity as a floating number of degrees per second.
+func (w AngularVelocity) DPS() float64 {
+	return float64(w) / float64(RadianPerSecond) * 180 / math.Pi
+}
+
+// RPM returns the angular velocity as a floating number of revolutions per
+// minute.
+func (w AngularVelocity) RPM() float64 {
+	return float64(w) / float64(RadianPerSecond) * 30 / math.Pi
+}
+
+// Frequency returns the number of turns per second at this angular velocity,
+// one cycle being one turn of 2π radians.
+func (w AngularVelocity) Frequency() Frequency {
+	v, _ := mulDiv(int64(w), hertzDen, hertzNum)
+	return Frequency(v)
+}
+
+// AngularVelocity returns the angular velocity of a rotation of one turn of 2π
+// radians per cycle. The result saturates at the highest representable
+// AngularVelocity.
+func (f Frequency) AngularVelocity() AngularVelocity {
+	v, overflow := mulDiv(int64(f), hertzNum, hertzDen)
+	if overflow {
+		if f < 0 {
+			return minAngularVelocity
+		}
+		return maxAngularVelocity
+	}
+	return AngularVelocity(v)
+}
+
+// Mul returns the angle swept at the angular velocity w during d, w×d. The
+// result saturates at the highest representable Angle.
+func (w AngularVelocity) Mul(d time.Duration) Angle {
+	if d < 0 {
+		w, d = -w, -d
+	}
+	v, overflow := mulDiv(int64(w), int64(d), int64(time.Second))
+	if overflow {
+		if w < 0 {
+			return minAngle
+		}
+		return maxAngle
+	}
+	return Angle(v)
+}
+
+// Div returns the constant angular velocity that sweeps the angle a in the
+// duration d, a/d.
+//
+// A 0s duration returns a 0rad/s angular velocity. An angular velocity that
+// cannot be represented saturates.
+func (a Angle) Div(d time.Duration) AngularVelocity {
+	if d == 0 {
+		return 0
+	}
+	if d < 0 {
+		a, d = -a, -d
+	}
+	v, overflow := mulDiv(int64(a), int64(time.Second), int64(d))
+	if overflow {
+		if a < 0 {
+			return minAngularVelocity
+		}
+		return maxAngularVelocity
+	}
+	return AngularVelocity(v)
+}
+
+const (
+	// RadianPerSecond is rad/s.
+	NanoRadianPerSecond  AngularVelocity = 1
+	MicroRadianPerSecond AngularVelocity = 1000 * NanoRadianPerSecond
+	MilliRadianPerSecond AngularVelocity = 1000 * MicroRadianPerSecond
+	RadianPerSecond      AngularVelocity = 1000 * MilliRadianPerSecond
+	KiloRadianPerSecond  AngularVelocity = 1000 * RadianPerSecond
+	MegaRadianPerSecond  AngularVelocity = 1000 * KiloRadianPerSecond
+	GigaRadianPerSecond  AngularVelocity = 1000 * MegaRadianPerSecond
+
+	// DegreePerSecond and RevolutionPerMinute are rounded to the nearest nano
+	// radian per second.
+	DegreePerSecond     AngularVelocity = 17453293 * NanoRadianPerSecond
+	RevolutionPerMinute AngularVelocity = 104719755 * NanoRadianPerSecond
+
+	maxAngularVelocity AngularVelocity = (1 << 63) - 1
+	minAngularVelocity AngularVelocity = -((1 << 63) - 1)
+
+	// hertzNum/hertzDen is 2π, one turn per second, in nano radians per second
+	// per micro hertz. It is 60 times the factor of "rpm" in Set and
+	// FormatUnit, unlike Theta which is truncated to the nano radian.
+	hertzNum = 628318530717958620
+	hertzDen = 100000000000000
+)
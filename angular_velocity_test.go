@@ -0,0 +1,267 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAngularVelocity_String(t *testing.T) {
+	if s := NanoRadianPerSecond.String(); s != "1nrad/s" {
+		t.Fatalf("%v", s)
+	}
+	if s := RadianPerSecond.String(); s != "1rad/s" {
+		t.Fatalf("%v", s)
+	}
+	if s := GigaRadianPerSecond.String(); s != "1Grad/s" {
+		t.Fatalf("%v", s)
+	}
+	if s := DegreePerSecond.String(); s != "17.453mrad/s" {
+		t.Fatalf("%v", s)
+	}
+	if s := RevolutionPerMinute.String(); s != "104.720mrad/s" {
+		t.Fatalf("%v", s)
+	}
+}
+
+func TestAngularVelocity_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected AngularVelocity
+	}{
+		{"1nrad/s", 1 * NanoRadianPerSecond},
+		{"1urad/s", 1 * MicroRadianPerSecond},
+		{"1µrad/s", 1 * MicroRadianPerSecond},
+		{"1mrad/s", 1 * MilliRadianPerSecond},
+		{"1rad/s", 1 * RadianPerSecond},
+		{"1krad/s", 1 * KiloRadianPerSecond},
+		{"1Mrad/s", 1 * MegaRadianPerSecond},
+		{"1Grad/s", 1 * GigaRadianPerSecond},
+		{"-12.345rad/s", -12345 * MilliRadianPerSecond},
+		{"9.223372036854775807Grad/s", maxAngularVelocity},
+		{"-9.223372036854775807Grad/s", minAngularVelocity},
+		{"1°/s", DegreePerSecond},
+		{"1deg/s", DegreePerSecond},
+		{"1dps", DegreePerSecond},
+		{"-250dps", -4363323130 * NanoRadianPerSecond},
+		{"2kdps", 34906585040 * NanoRadianPerSecond},
+		{"360°/s", 6283185307 * NanoRadianPerSecond},
+		{"1rpm", RevolutionPerMinute},
+		{"1RPM", RevolutionPerMinute},
+		{"60rpm", 6283185307 * NanoRadianPerSecond},
+		{"7.2krpm", 753982236862 * NanoRadianPerSecond},
+		{"528460290544dps", 9223372035300677060},
+		{"88076715144rpm", 9223372035284472010},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10Grad/s",
+			"maximum value is 9.223Grad/s",
+		},
+		{
+			"-10Grad/s",
+			"minimum value is -9.223Grad/s",
+		},
+		{
+			"10Erad/s",
+			"unknown unit prefix; valid prefixes for \"rad/s\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"528460290545dps",
+			"maximum value is 528460290544dps",
+		},
+		{
+			"-1T°/s",
+			"minimum value is -528460290544°/s",
+		},
+		{
+			"88076715145rpm",
+			"maximum value is 88076715144rpm",
+		},
+		{
+			"10",
+			"no unit provided; need rad/s, °/s, dps or rpm",
+		},
+		{
+			"1random",
+			"unknown unit provided; need rad/s, °/s, dps or rpm",
+		},
+		{
+			"9223372036854775808",
+			"maximum value is 9.223Grad/s",
+		},
+		{
+			"-9223372036854775808",
+			"minimum value is -9.223Grad/s",
+		},
+		{
+			"dps",
+			"not a number",
+		},
+		{
+			"RPN",
+			"does not contain number or unit rad/s, °/s, dps or rpm",
+		},
+		{
+			"++1rpm",
+			"contains multiple plus symbols",
+		},
+		{
+			"1.1.1.1rad/s",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got AngularVelocity
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: AngularVelocity.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: AngularVelocity.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got AngularVelocity
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: AngularVelocity.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestAngularVelocity_RoundTrip(t *testing.T) {
+	x := 123 * MilliRadianPerSecond
+	var y AngularVelocity
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("AngularVelocity.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("AngularVelocity expected %s to equal %s", x, y)
+	}
+}
+
+func TestAngularVelocity_DPS(t *testing.T) {
+	if v := AngularVelocity(-Pi).DPS(); v < -180.0000001 || v > -179.9999999 {
+		t.Fatal(v)
+	}
+}
+
+func TestAngularVelocity_RPM(t *testing.T) {
+	if v := AngularVelocity(Theta).RPM(); v < 59.9999999 || v > 60.0000001 {
+		t.Fatal(v)
+	}
+}
+
+func TestAngularVelocity_Frequency(t *testing.T) {
+	data := []struct {
+		in       AngularVelocity
+		expected Frequency
+	}{
+		{0, 0},
+		{AngularVelocity(Theta), Hertz},
+		{-AngularVelocity(Theta), -Hertz},
+		{60 * RevolutionPerMinute, Hertz},
+		{RadianPerSecond, 159155 * MicroHertz},
+		{maxAngularVelocity, 1467945251641001 * MicroHertz},
+	}
+	for i, line := range data {
+		if v := line.in.Frequency(); v != line.expected {
+			t.Fatalf("%d: %s.Frequency() = %s(%d) != %s(%d)", i, line.in, v, v, line.expected, line.expected)
+		}
+	}
+}
+
+func TestAngularVelocity_FrequencyRPM(t *testing.T) {
+	// Set and Frequency use the same factor, so a speed parsed in rpm converts
+	// to an exact number of hertz.
+	for i, line := range []struct {
+		in       string
+		expected Frequency
+	}{
+		{"60rpm", Hertz},
+		{"600000rpm", 10 * KiloHertz},
+		{"-6Grpm", -100 * MegaHertz},
+	} {
+		var w AngularVelocity
+		if err := w.Set(line.in); err != nil {
+			t.Fatalf("%d: Set(%s) failed: %v", i, line.in, err)
+		}
+		if v := w.Frequency(); v != line.expected {
+			t.Fatalf("%d: %s.Frequency() = %s(%d) != %s(%d)", i, line.in, v, v, line.expected, line.expected)
+		}
+		if v := line.expected.AngularVelocity(); v != w {
+			t.Fatalf("%d: %s.AngularVelocity() = %s(%d) != %s(%d)", i, line.expected, v, v, w, w)
+		}
+	}
+}
+
+func TestFrequency_AngularVelocity(t *testing.T) {
+	data := []struct {
+		in       Frequency
+		expected AngularVelocity
+	}{
+		{0, 0},
+		{Hertz, AngularVelocity(Theta)},
+		{-50 * Hertz, -314159265359 * NanoRadianPerSecond},
+		{RPM, 104721850 * NanoRadianPerSecond},
+		{TeraHertz, maxAngularVelocity},
+		{-TeraHertz, minAngularVelocity},
+	}
+	for i, line := range data {
+		if v := line.in.AngularVelocity(); v != line.expected {
+			t.Fatalf("%d: %s.AngularVelocity() = %s(%d) != %s(%d)", i, line.in, v, v, line.expected, line.expected)
+		}
+	}
+}
+
+func TestAngularVelocity_Mul(t *testing.T) {
+	data := []struct {
+		w        AngularVelocity
+		d        time.Duration
+		expected Angle
+	}{
+		{RadianPerSecond, time.Second, Radian},
+		{90 * DegreePerSecond, 2 * time.Second, 180 * Degree},
+		{-RadianPerSecond, 500 * time.Millisecond, -500 * MilliRadian},
+		{RadianPerSecond, -time.Second, -Radian},
+		{RadianPerSecond, 0, 0},
+		{GigaRadianPerSecond, time.Hour, maxAngle},
+		{-GigaRadianPerSecond, time.Hour, minAngle},
+	}
+	for i, line := range data {
+		if v := line.w.Mul(line.d); v != line.expected {
+			t.Fatalf("%d: %s.Mul(%s) = %s(%d) != %s(%d)", i, line.w, line.d, v, v, line.expected, line.expected)
+		}
+	}
+}
+
+func TestAngle_Div(t *testing.T) {
+	data := []struct {
+		a        Angle
+		d        time.Duration
+		expected AngularVelocity
+	}{
+		{Radian, time.Second, RadianPerSecond},
+		{Theta, time.Minute, RevolutionPerMinute},
+		{-Pi, 2 * time.Second, -1570796327 * NanoRadianPerSecond},
+		{Radian, -time.Second, -RadianPerSecond},
+		{Radian, 0, 0},
+		{maxAngle, time.Nanosecond, maxAngularVelocity},
+		{minAngle, time.Nanosecond, minAngularVelocity},
+	}
+	for i, line := range data {
+		if v := line.a.Div(line.d); v != line.expected {
+			t.Fatalf("%d: %s.Div(%s) = %s(%d) != %s(%d)", i, line.a, line.d, v, v, line.expected, line.expected)
+		}
+	}
+}
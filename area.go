@@ -0,0 +1,318 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Area is a measurement of the extent of a surface stored as an int64 square
+// millimetre.
+//
+// The SI prefix of a square unit applies to the length before it is squared,
+// so a square kilometre is a million square metres. Area is therefore
+// formatted in "mm²", "m²" or "km²" rather than with an arbitrary SI prefix.
+//
+// The highest representable value is 9.2 million km².
+type Area int64
+
+// String returns the area formatted as a string in mm², m² or km², whichever
+// is the largest that is not greater than the area.
+func (a Area) String() string {
+	var buf [32]byte
+	return string(appendArea(buf[:0], a, siFormat{}))
+}
+
+// appendArea appends the area to b in mm², m² or km² as selected by String,
+// formatted as described by f.
+func appendArea(b []byte, a Area, f siFormat) []byte {
+	if a == 0 && f.mode != siFixed {
+		return append(b, "0m²"...)
+	}
+	u := uint64(a)
+	if a < 0 {
+		b = append(b, '-')
+		u = -u
+	}
+	shift, symbol := 0, "mm²"
+	switch {
+	case u >= uint64(SquareKilometre):
+		shift, symbol = 12, "km²"
+	case u >= uint64(SquareMetre):
+		shift, symbol = 6, "m²"
+	}
+	var prec int
+	switch f.mode {
+	case siFixed:
+		prec = f.digits
+	case siExact:
+		prec = -1
+	default:
+		// Three digits after the decimal point, omitted when they are all
+		// zeros.
+		if shift > 0 && roundShift(u, shift, 3)%1000 != 0 {
+			prec = 3
+		}
+	}
+	b = appendDecimal(b, u, shift, prec)
+	return append(b, symbol...)
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (a Area) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(a), "mm²", func(b []byte, prec int) []byte {
+		return appendArea(b, a, siPrecision(prec))
+	})
+}
+
+// FormatUnit returns the area formatted in one of the units accepted by Set,
+// for example "cm²", "ha", "ft²" or "sq mi", rounded to precision digits after
+// the decimal point. A negative precision uses as many digits as necessary to
+// represent the area exactly.
+func (a Area) FormatUnit(symbol string, precision int) (string, error) {
+	// Same conversion factors as Set.
+	num, den := int64(0), int64(1)
+	switch symbol {
+	case "mm²", "mm2":
+		num = int64(SquareMillimetre)
+	case "cm²", "cm2":
+		num = int64(SquareCentimetre)
+	case "m²", "m2":
+		num = int64(SquareMetre)
+	case "km²", "km2":
+		num = int64(SquareKilometre)
+	case "ha":
+		num = int64(Hectare)
+	case "ft²", "ft2", "sq ft":
+		num, den = 9290304, 100
+	case "in²", "in2", "sq in":
+		num, den = 64516, 100
+	case "acre", "ac":
+		num, den = 40468564224, 10
+	case "sq mi", "mi²", "mi2":
+		num = int64(SquareMile)
+	default:
+		return "", incorrectUnitErr("m², cm², mm², km², ha, ft², in², acre or sq mi")
+	}
+	return formatUnit(int64(a), symbol, precision, scaledUnit{symbol, num, den})
+}
+
+// Set sets the Area to the value represented by s. Units are to be provided in
+// "m²", "cm²", "mm²", "km²", "ha" (hectare), "ft²", "in²", "acre" or "ac", or
+// "sq mi". The square units may also be written with a plain "2", for example
+// "m2", or as "sq ft", "sq in" or "mi²". Since the SI prefix of a square unit
+// is squared too, no other SI prefix is accepted.
+func (a *Area) Set(s string) error {
+	d, n, err := atod(s)
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s[n:], areaUnits...); found != "" {
+					return err
+				}
+				return notNumberUnitErr("m², cm², mm², km², ha, ft², in², acre or sq mi")
+			case errOverflowsInt64:
+				return maxValueErr(maxArea.String())
+			case errOverflowsInt64Negative:
+				return minValueErr(minArea.String())
+			}
+		}
+		return err
+	}
+
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+	}
+
+	// factor is the value of the unit in square millimetres and maxUnit is the
+	// largest representable value in the unit.
+	var factor decimal
+	var maxUnit int64
+	switch s[n:] {
+	case "mm²", "mm2":
+		factor, maxUnit = decimal{base: 1}, 9223372036854775807
+	case "cm²", "cm2":
+		factor, maxUnit = decimal{base: 1, exp: 2}, 92233720368547758
+	case "m²", "m2":
+		factor, maxUnit = decimal{base: 1, exp: 6}, 9223372036854
+	case "km²", "km2":
+		factor, maxUnit = decimal{base: 1, exp: 12}, 9223372
+	case "ha":
+		factor, maxUnit = decimal{base: 1, exp: 10}, 922337203
+	case "ft²", "ft2", "sq ft":
+		factor, maxUnit = decimal{base: 9290304, exp: -2}, 99279550344644
+	case "in²", "in2", "sq in":
+		factor, maxUnit = decimal{base: 64516, exp: -2}, 14296255249635444
+	case "acre", "ac":
+		factor, maxUnit = decimal{base: 40468564224, exp: -1}, 2279144866
+	case "sq mi", "mi²", "mi2":
+		factor, maxUnit = decimal{base: 2589988110336}, 3561163
+	case "":
+		return noUnitErr("m², cm², mm², km², ha, ft², in², acre or sq mi")
+	default:
+		if found := hasSuffixes(s[n:], areaUnits...); found != "" {
+			return errors.New("\"" + found + "\" does not accept an SI prefix")
+		}
+		return incorrectUnitErr("m², cm², mm², km², ha, ft², in², acre or sq mi")
+	}
+	v, lossy, overflow := dtoiScaled(d, factor, unit)
+	if lossy {
+		return errors.New("converting to square millimetres would overflow, consider using mm² for maximum precision")
+	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + strconv.FormatInt(maxUnit, 10) + s[n:])
+		}
+		return maxValueErr(strconv.FormatInt(maxUnit, 10) + s[n:])
+	}
+	if roundsToZero(d, v) {
+		return resolutionErr(SquareMillimetre.String())
+	}
+	*a = Area(v)
+	return nil
+}
+
+// areaUnits are the units accepted by Area.Set.
+var areaUnits = []string{"m²", "m2", "ha", "ft²", "ft2", "sq ft", "in²", "in2", "sq in", "acre", "ac", "sq mi", "mi²", "mi2"}
+
+// MarshalJSON implements json.Marshaler. The Area is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (a Area) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of square millimetres.
+func (a *Area) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, a.Set, func(v int64) error {
+		*a = Area(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Area to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (a Area) AppendText(b []byte) ([]byte, error) {
+	return appendArea(b, a, siFormat{mode: siExact}), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Area is encoded as
+// appended by AppendText.
+func (a Area) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (a *Area) UnmarshalText(text []byte) error {
+	return a.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Area is stored as an integer of square
+// millimetres.
+func (a Area) Value() (driver.Value, error) {
+	return int64(a), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of square
+// millimetres or text in a format understood by Set.
+func (a *Area) Scan(src any) error {
+	return scanValue(src, a.Set, func(v int64) error {
+		*a = Area(v)
+		return nil
+	})
+}
+
+// M2 returns the area as a floating number of square metres.
+func (a Area) M2() float64 {
+	return float64(a) / float64(SquareMetre)
+}
+
+// Mul returns the area of a rectangle of sides d and e, d×e, rounded to the
+// nearest square millimetre. The result saturates at the highest
+// representable Area.
+func (d Distance) Mul(e Distance) Area {
+	if e < 0 {
+		d, e = -d, -e
+	}
+	v, overflow := mulDiv(int64(d), int64(e), 1000000000000)
+	if overflow {
+		if d < 0 {
+			return minArea
+		}
+		return maxArea
+	}
+	return Area(v)
+}
+
+// Mul returns the volume of a prism of base a and height d, a×d. The result
+// saturates at the highest representable Volume.
+func (a Area) Mul(d Distance) Volume {
+	if d < 0 {
+		a, d = -a, -d
+	}
+	// One square millimetre times one nano metre is a thousandth of a nano
+	// litre.
+	v, overflow := mulDiv(int64(a), int64(d), 1000)
+	if overflow {
+		if a < 0 {
+			return minVolume
+		}
+		return maxVolume
+	}
+	return Volume(v)
+}
+
+// Div returns the pressure exerted by the force f spread over the area a, f/a.
+//
+// A 0m² area returns a 0Pa pressure. A pressure that cannot be represented
+// saturates.
+func (f Force) Div(a Area) Pressure {
+	if a == 0 {
+		return 0
+	}
+	if a < 0 {
+		f, a = -f, -a
+	}
+	v, overflow := mulDiv(int64(f), int64(SquareMetre), int64(a))
+	if overflow {
+		if f < 0 {
+			return minPressure
+		}
+		return maxPressure
+	}
+	return Pressure(v)
+}
+
+const (
+	SquareMillimetre Area = 1
+	SquareCentimetre Area = 100 * SquareMillimetre
+	SquareMetre      Area = 1000000 * SquareMillimetre
+	Hectare          Area = 10000 * SquareMetre
+	SquareKilometre  Area = 1000000 * SquareMetre
+
+	// Imperial units of area, derived from the international foot of exactly
+	// 0.3048m. SquareInch and SquareFoot are rounded to the nearest square
+	// millimetre.
+	SquareInch Area = 645 * SquareMillimetre
+	SquareFoot Area = 92903 * SquareMillimetre
+	Acre       Area = 4046856422 * SquareMillimetre
+	SquareMile Area = 2589988110336 * SquareMillimetre
+
+	maxArea Area = (1 << 63) - 1
+	minArea Area = -((1 << 63) - 1)
+)
@@ -0,0 +1,247 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestArea_String(t *testing.T) {
+	data := []struct {
+		in       Area
+		expected string
+	}{
+		{0, "0m²"},
+		{SquareMillimetre, "1mm²"},
+		{-SquareCentimetre, "-100mm²"},
+		{500000 * SquareMillimetre, "500000mm²"},
+		{SquareMetre, "1m²"},
+		{12500 * SquareCentimetre, "1.250m²"},
+		{1500 * SquareMetre, "1500m²"},
+		{Hectare, "10000m²"},
+		{Acre, "4046.856m²"},
+		{SquareKilometre, "1km²"},
+		{SquareMile, "2.590km²"},
+		{-SquareMile, "-2.590km²"},
+		{maxArea, "9223372.037km²"},
+		{minArea, "-9223372.037km²"},
+	}
+	for i, line := range data {
+		if s := line.in.String(); s != line.expected {
+			t.Fatalf("%d: Area(%d).String() = %s != %s", i, int64(line.in), s, line.expected)
+		}
+	}
+}
+
+func TestArea_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected Area
+	}{
+		{"1mm²", SquareMillimetre},
+		{"1mm2", SquareMillimetre},
+		{"1cm²", SquareCentimetre},
+		{"-3cm2", -3 * SquareCentimetre},
+		{"1m²", SquareMetre},
+		{"1m2", SquareMetre},
+		{"0.5m²", 500000 * SquareMillimetre},
+		{"1km²", SquareKilometre},
+		{"1km2", SquareKilometre},
+		{"1ha", Hectare},
+		{"12.5ha", 125000 * SquareMetre},
+		{"1ft²", SquareFoot},
+		{"1ft2", SquareFoot},
+		{"1sq ft", SquareFoot},
+		{"100ft²", 9290304 * SquareMillimetre},
+		{"1in²", SquareInch},
+		{"100in2", 64516 * SquareMillimetre},
+		{"1sq in", SquareInch},
+		{"1acre", Acre},
+		{"10ac", 40468564224 * SquareMillimetre},
+		{"1sq mi", SquareMile},
+		{"1mi²", SquareMile},
+		{"1mi2", SquareMile},
+		{"9223372036854775807mm²", maxArea},
+		{"-9223372036854775807mm²", minArea},
+		{fmt.Sprintf("%dkm²", 9223372), 9223372000000000000},
+		{"99279550344644ft²", 9223372036846387584},
+		{"2279144866acre", 9223372033082139720},
+		{"3561163sq mi", 9223369828968480768},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"9223373km²",
+			"maximum value is 9223372km²",
+		},
+		{
+			"-9223373km2",
+			"minimum value is -9223372km2",
+		},
+		{
+			"922337204ha",
+			"maximum value is 922337203ha",
+		},
+		{
+			"99279550344645ft²",
+			"maximum value is 99279550344644ft²",
+		},
+		{
+			"2279144867acre",
+			"maximum value is 2279144866acre",
+		},
+		{
+			"-3561164sq mi",
+			"minimum value is -3561163sq mi",
+		},
+		{
+			"9223372036854775808mm²",
+			"maximum value is 9223372.037km²",
+		},
+		{
+			"-9223372036854775808mm²",
+			"minimum value is -9223372.037km²",
+		},
+		{
+			"1µm²",
+			"\"m²\" does not accept an SI prefix",
+		},
+		{
+			"1kha",
+			"\"ha\" does not accept an SI prefix",
+		},
+		{
+			"10",
+			"no unit provided; need m², cm², mm², km², ha, ft², in², acre or sq mi",
+		},
+		{
+			"1km",
+			"unknown unit provided; need m², cm², mm², km², ha, ft², in², acre or sq mi",
+		},
+		{
+			"ha",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit m², cm², mm², km², ha, ft², in², acre or sq mi",
+		},
+		{
+			"++1m²",
+			"contains multiple plus symbols",
+		},
+		{
+			"1.1.1.1m²",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got Area
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: Area.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: Area.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got Area
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: Area.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestArea_RoundTrip(t *testing.T) {
+	for _, x := range []Area{0, 1234 * SquareMillimetre, 1250 * SquareMetre, 3 * SquareKilometre} {
+		var y Area
+		if err := y.Set(x.String()); err != nil {
+			t.Fatalf("Area.Set(stringer) failed: %v", err)
+		}
+		if x != y {
+			t.Fatalf("Area expected %s to equal %s", x, y)
+		}
+	}
+}
+
+func TestArea_M2(t *testing.T) {
+	if v := Area(123 * SquareMetre).M2(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestDistance_Mul(t *testing.T) {
+	data := []struct {
+		d, e     Distance
+		expected Area
+	}{
+		{Metre, Metre, SquareMetre},
+		{3 * Metre, 4 * Metre, 12 * SquareMetre},
+		{-Metre, MilliMetre, -1000 * SquareMillimetre},
+		{Metre, -Metre, -SquareMetre},
+		{-Metre, -Metre, SquareMetre},
+		{Inch, Inch, SquareInch},
+		{MilliMetre, 400 * MicroMetre, 0},
+		{MilliMetre, 500 * MicroMetre, SquareMillimetre},
+		{GigaMetre, GigaMetre, maxArea},
+		{-GigaMetre, GigaMetre, minArea},
+	}
+	for i, line := range data {
+		if v := line.d.Mul(line.e); v != line.expected {
+			t.Fatalf("%d: %s.Mul(%s) = %s(%d) != %s(%d)", i, line.d, line.e, v, v, line.expected, line.expected)
+		}
+	}
+}
+
+func TestArea_Mul(t *testing.T) {
+	data := []struct {
+		a        Area
+		d        Distance
+		expected Volume
+	}{
+		{SquareMetre, Metre, CubicMetre},
+		{SquareCentimetre, 10 * MilliMetre, CubicCentimetre},
+		{SquareMillimetre, MilliMetre, CubicMillimetre},
+		{2 * SquareMetre, -MilliMetre, -2 * Litre},
+		{-SquareMetre, -Metre, CubicMetre},
+		{SquareKilometre, KiloMetre, maxVolume},
+		{-SquareKilometre, KiloMetre, minVolume},
+	}
+	for i, line := range data {
+		if v := line.a.Mul(line.d); v != line.expected {
+			t.Fatalf("%d: %s.Mul(%s) = %s(%d) != %s(%d)", i, line.a, line.d, v, v, line.expected, line.expected)
+		}
+	}
+}
+
+func TestForce_Div(t *testing.T) {
+	data := []struct {
+		f        Force
+		a        Area
+		expected Pressure
+	}{
+		{Newton, SquareMetre, Pascal},
+		{Newton, SquareMillimetre, MegaPascal},
+		{-10 * Newton, SquareCentimetre, -100 * KiloPascal},
+		{Newton, -SquareMetre, -Pascal},
+		{PoundForce, SquareInch, 6896467620155 * NanoPascal},
+		{Newton, 0, 0},
+		{GigaNewton, SquareMillimetre, maxPressure},
+		{-GigaNewton, SquareMillimetre, minPressure},
+	}
+	for i, line := range data {
+		if v := line.f.Div(line.a); v != line.expected {
+			t.Fatalf("%d: %s.Div(%s) = %s(%d) != %s(%d)", i, line.f, line.a, v, v, line.expected, line.expected)
+		}
+	}
+}
@@ -7,7 +7,11 @@
 package unit
 
 import (
+	"database/sql/driver"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -20,19 +24,108 @@ type Distance int64
 
 // String returns the distance formatted as a string in metre.
 func (d Distance) String() string {
-	return nanoAsString(int64(d)) + "m"
+	return formatSI(int64(d), nano, siFormat{}) + "m"
 }
 
-// Set sets the Distance to the value represented by s. Units are to
-// be provided in "m", "Mile", "Yard", "in", or "ft" with an optional SI
-// prefix: "p", "n", "u", "µ", "m", "k", "M", "G" or "T".
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (d Distance) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(d), "nm", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(d), nano, siPrecision(prec)), 'm')
+	})
+}
+
+// FormatUnit returns the distance formatted in one of the units accepted by
+// Set, for example "m", "Mile", "ft", "in", "nmi" or "thou", with an optional
+// SI prefix, rounded to precision digits after the decimal point. A negative
+// precision uses as many digits as necessary to represent the distance exactly.
+// "AU" is not supported since one AU is more than the highest representable
+// distance.
+//
+// Use FeetInches to format a distance as feet and inches.
+func (d Distance) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(d), symbol, precision,
+		scaledUnit{"Mile", int64(Mile), 1},
+		scaledUnit{"mile", int64(Mile), 1},
+		scaledUnit{"Yard", int64(Yard), 1},
+		scaledUnit{"yard", int64(Yard), 1},
+		scaledUnit{"ft", int64(Foot), 1},
+		scaledUnit{"in", int64(Inch), 1},
+		scaledUnit{"thou", int64(Thou), 1},
+		scaledUnit{"mil", int64(Thou), 1},
+		scaledUnit{"nmi", int64(NauticalMile), 1},
+		scaledUnit{"NM", int64(NauticalMile), 1},
+		scaledUnit{"ch", int64(Chain), 1},
+		scaledUnit{"fur", int64(Furlong), 1},
+		scaledUnit{"m", int64(Metre), 1},
+	)
+}
+
+// FeetInches returns the distance formatted as feet and inches, with the
+// inches rounded to the nearest 1/denominator of an inch, as in 5'11 1/2". The
+// fraction is reduced and omitted when zero. Common denominators are 16 and 32;
+// a denominator less than 1 rounds to whole inches.
+//
+// The result is understood by Set.
+func (d Distance) FeetInches(denominator int64) string {
+	if denominator < 1 {
+		denominator = 1
+	}
+	var buf [48]byte
+	b := buf[:0]
+	u := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		u = uint64(-d)
+	}
+	inches := u / uint64(Inch)
+	num := ((u%uint64(Inch))*uint64(denominator)*2 + uint64(Inch)) / (2 * uint64(Inch))
+	if num == uint64(denominator) {
+		inches++
+		num = 0
+	}
+	b = strconv.AppendUint(b, inches/12, 10)
+	b = append(b, '\'')
+	b = strconv.AppendUint(b, inches%12, 10)
+	if num != 0 {
+		den := uint64(denominator)
+		for x, y := num, den; ; {
+			if y == 0 {
+				num /= x
+				den /= x
+				break
+			}
+			x, y = y, x%y
+		}
+		b = append(b, ' ')
+		b = strconv.AppendUint(b, num, 10)
+		b = append(b, '/')
+		b = strconv.AppendUint(b, den, 10)
+	}
+	return string(append(b, '"'))
+}
+
+// Set sets the Distance to the value represented by s. Units are to be provided
+// in "m", "Mile", "Yard", "ft", "in", "thou" or "mil" (1/1000in), "nmi" or "NM"
+// (nautical mile), "ch" (chain), "fur" (furlong) or "AU" (astronomical unit)
+// with an optional SI prefix: "p", "n", "u", "µ", "m", "c", "d", "da", "h",
+// "k", "M", "G" or "T". "'" and "\"" are accepted for feet and inches.
+//
+// Feet may be followed by inches, as in 5'11", "5ft 11in" or "5 ft 11 in", and
+// the inches of such a compound length may end with a fraction, as in
+// 5'11 1/2".
+//
+// The largest representable distance is only about 0.06AU.
 func (d *Distance) Set(s string) error {
+	if isFeetInches(s) {
+		return d.setFeetInches(s)
+	}
 	dc, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s[n:], "in", "ft", "Yard", "yard", "Mile", "mile", "m"); found != "" {
+				if found := hasSuffixes(s[n:], distanceUnits...); found != "" {
 					return err
 				}
 				return notNumberUnitErr("m, Mile, in, ft or Yard")
@@ -52,15 +145,12 @@ func (d *Distance) Set(s string) error {
 		if r <= 1 || rsize == 0 {
 			return errors.New("unexpected end of string")
 		}
-		var siSize int
-		si, siSize = parseSIPrefix(r)
-		if si == milli || si == mega {
-			switch s[n:] {
-			case "m", "Mile", "mile":
-				si = unit
-			}
-		}
-		if si != unit {
+		switch s[n:] {
+		case "m", "Mile", "mile", "mil", "nmi", "NM", "ft", "fur", "thou", "ch", "AU", "au":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
 			n += siSize
 		}
 	}
@@ -71,9 +161,16 @@ func (d *Distance) Set(s string) error {
 		}
 		return maxValueErr(maxDistance.String())
 	}
+	if roundsToZero(dc, v) {
+		return resolutionErr(NanoMetre.String())
+	}
+	// num/den is the value of the unit in metres, for the units not handled
+	// in the switch below.
+	var num, den int64
 	switch s[n:] {
 	case "m":
 		*d = (Distance)(v)
+		return nil
 	case "Mile", "mile":
 		switch {
 		case v > maxMiles:
@@ -85,6 +182,7 @@ func (d *Distance) Set(s string) error {
 		default:
 			*d = (Distance)((v*1609344 - 500) / 1000)
 		}
+		return nil
 	case "Yard", "yard":
 		switch {
 		case v > maxYards:
@@ -96,7 +194,8 @@ func (d *Distance) Set(s string) error {
 		default:
 			*d = (Distance)((v*9144 - 5000) / 10000)
 		}
-	case "ft":
+		return nil
+	case "ft", "'":
 		switch {
 		case v > maxFeet:
 			return maxValueErr("3 Million ft")
@@ -107,7 +206,8 @@ func (d *Distance) Set(s string) error {
 		default:
 			*d = (Distance)((v*3048 - 5000) / 10000)
 		}
-	case "in":
+		return nil
+	case "in", "\"":
 		switch {
 		case v > maxInches:
 			return maxValueErr("36 Million inch")
@@ -118,17 +218,176 @@ func (d *Distance) Set(s string) error {
 		default:
 			*d = (Distance)((v*254 - 5000) / 10000)
 		}
+		return nil
+	case "thou", "mil":
+		num, den = 254, 10000000
+	case "nmi", "NM":
+		num, den = 1852, 1
+	case "ch":
+		num, den = 12573, 625
+	case "fur":
+		num, den = 25146, 125
+	case "AU", "au":
+		num, den = 149597870700, 1
 	case "":
 		return noUnitErr("m, Mile, in, ft or Yard")
 	default:
-		if found := hasSuffixes(s[n:], "in", "ft", "Yard", "Mile", "m"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], distanceUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
 		return incorrectUnitErr("m, Mile, in, ft or Yard")
 	}
+	x, overflow := mulDiv(v, num, den)
+	if overflow {
+		if v < 0 {
+			return minValueErr(minDistance.String())
+		}
+		return maxValueErr(maxDistance.String())
+	}
+	if roundsToZero(dc, x) {
+		return resolutionErr(NanoMetre.String())
+	}
+	*d = (Distance)(x)
 	return nil
 }
 
+// distanceUnits are the units accepted by Distance.Set.
+var distanceUnits = []string{"in", "ft", "Yard", "yard", "Mile", "mile", "thou", "mil", "nmi", "NM", "ch", "fur", "AU", "au", "m", "'", "\""}
+
+// isFeetInches reports whether s is a compound length of feet followed by
+// inches, or inches with a fraction.
+func isFeetInches(s string) bool {
+	if strings.Contains(s, "/") {
+		return true
+	}
+	if i := strings.IndexAny(s, "'f"); i != -1 {
+		rest := s[i+1:]
+		if s[i] == 'f' {
+			if !strings.HasPrefix(rest, "t") {
+				return false
+			}
+			rest = rest[1:]
+		}
+		return strings.TrimSpace(rest) != ""
+	}
+	return false
+}
+
+// setFeetInches parses the lengths detected by isFeetInches.
+func (d *Distance) setFeetInches(s string) error {
+	neg := strings.HasPrefix(s, "-")
+	if neg || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	var feet Distance
+	if i := strings.IndexAny(s, "'f"); i != -1 {
+		end := i + len("'")
+		if s[i] == 'f' {
+			end = i + len("ft")
+		}
+		// The compound form may have spaces between its parts, as in
+		// "5 ft 11 in", which Set does not accept before a unit.
+		if err := feet.Set(strings.TrimSpace(s[:i]) + s[i:end]); err != nil {
+			return err
+		}
+		s = strings.TrimSpace(s[end:])
+	}
+	if feet < 0 || strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		return errors.New("only the start of a feet and inches length can have a sign")
+	}
+	unit := hasSuffixes(s, "in", "\"")
+	if unit == "" {
+		return errors.New("feet must be followed by inches, as in 5'11\"")
+	}
+	s = strings.TrimSpace(s[:len(s)-len(unit)])
+
+	// The inches may be a whole number followed by a fraction, as in "11 1/2",
+	// "11-1/2" or "1/2".
+	var inches, frac Distance
+	if i := strings.IndexByte(s, '/'); i != -1 {
+		start := strings.LastIndexAny(s[:i], " -") + 1
+		num, err := strconv.ParseInt(s[start:i], 10, 32)
+		if err != nil {
+			return errors.New("invalid fraction of inch \"" + s[start:] + "\"")
+		}
+		den, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil || den <= 0 || num < 0 || num >= den {
+			return errors.New("invalid fraction of inch \"" + s[start:] + "\"")
+		}
+		v, _ := mulDiv(num, int64(Inch), den)
+		frac = Distance(v)
+		s = strings.TrimSpace(strings.TrimSuffix(s[:start], "-"))
+	}
+	if s != "" {
+		if err := inches.Set(s + "in"); err != nil {
+			return err
+		}
+		if inches < 0 {
+			return errors.New("only the start of a feet and inches length can have a sign")
+		}
+	}
+	inches += frac
+	if feet > maxDistance-inches {
+		if neg {
+			return minValueErr(minDistance.String())
+		}
+		return maxValueErr(maxDistance.String())
+	}
+	if *d = feet + inches; neg {
+		*d = -*d
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The Distance is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (d Distance) MarshalJSON() ([]byte, error) {
+	return marshalJSON(d.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano metres.
+func (d *Distance) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, d.Set, func(v int64) error {
+		*d = Distance(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Distance to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (d Distance) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(d), nano, siFormat{mode: siExact}), 'm'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Distance is encoded as
+// appended by AppendText.
+func (d Distance) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (d *Distance) UnmarshalText(text []byte) error {
+	return d.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Distance is stored as an integer of nano
+// metres.
+func (d Distance) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano metres or
+// text in a format understood by Set.
+func (d *Distance) Scan(src any) error {
+	return scanValue(src, d.Set, func(v int64) error {
+		*d = Distance(v)
+		return nil
+	})
+}
+
 const (
 	NanoMetre  Distance = 1
 	MicroMetre Distance = 1000 * NanoMetre
@@ -145,6 +404,13 @@ const (
 	Yard Distance = 3 * Foot
 	Mile Distance = 1760 * Yard
 
+	// Chain and Furlong are surveying units.
+	Chain   Distance = 22 * Yard
+	Furlong Distance = 10 * Chain
+
+	// NauticalMile is used in air and marine navigation.
+	NauticalMile Distance = 1852 * Metre
+
 	maxDistance       = 9223372036854775807 * NanoMetre
 	minDistance       = -9223372036854775807 * NanoMetre
 	maxMiles    int64 = (int64(maxDistance) - 500) / int64((Mile)/1000000) // ~Max/1609344
@@ -58,6 +58,42 @@ func TestDistance_Set(t *testing.T) {
 		{"1Yard", 914400 * MicroMetre},
 		{"1yard", 914400 * MicroMetre},
 		{"-1008680.231502051Yard", -922337203685475 * NanoMetre},
+		{"1thou", Thou},
+		{"1mil", Thou},
+		{"1000thou", Inch},
+		{"1nmi", NauticalMile},
+		{"1NM", NauticalMile},
+		{"-2.5NM", -4630 * Metre},
+		{"1knmi", 1852 * KiloMetre},
+		{"1ch", Chain},
+		{"1cm", 10 * MilliMetre},
+		{"2.5dm", 250 * MilliMetre},
+		{"1dam", 10 * Metre},
+		{"1hm", 100 * Metre},
+		{"10ch", Furlong},
+		{"1fur", Furlong},
+		{"8fur", Mile},
+		{"0.01AU", 1495978707 * Metre},
+		{"1mAU", 149597870700 * MilliMetre},
+		{"1µAU", 149597870700 * MicroMetre},
+		{"5'", 5 * Foot},
+		{"11\"", 11 * Inch},
+		{"5'11\"", 5*Foot + 11*Inch},
+		{"5' 11\"", 5*Foot + 11*Inch},
+		{"5ft 11in", 5*Foot + 11*Inch},
+		{"5ft11in", 5*Foot + 11*Inch},
+		{"5 ft 11 in", 5*Foot + 11*Inch},
+		{"5 ' 11 \"", 5*Foot + 11*Inch},
+		{"-5 ft 11 1/2 in", -(5*Foot + 11*Inch + Inch/2)},
+		{"-5'11\"", -(5*Foot + 11*Inch)},
+		{"5'11.5\"", 5*Foot + 11*Inch + Inch/2},
+		{"5'11 1/2\"", 5*Foot + 11*Inch + Inch/2},
+		{"5'11-1/2\"", 5*Foot + 11*Inch + Inch/2},
+		{"0'0 1/32\"", 793750 * NanoMetre},
+		{"1/16in", 1587500 * NanoMetre},
+		{"-1/16in", -1587500 * NanoMetre},
+		{"3 3/8in", 3*Inch + 9525*MicroMetre},
+		{"2147483646/2147483647in", Inch},
 		{"3026040.694506158ft", 922337203685477 * NanoMetre},
 		{"-3.026040694506158Mft", -922337203685477 * NanoMetre},
 		{"36.312488334073900Min", 922337203685477 * NanoMetre},
@@ -72,13 +108,21 @@ func TestDistance_Set(t *testing.T) {
 			"10Tm",
 			"maximum value is 9.223Gm",
 		},
+		{
+			"1pm",
+			"nonzero value rounds to zero; resolution is 1nm",
+		},
+		{
+			"1nthou",
+			"nonzero value rounds to zero; resolution is 1nm",
+		},
 		{
 			"10Em",
-			"unknown unit prefix; valid prefixes for \"m\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"m\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10Exam",
-			"unknown unit prefix; valid prefixes for \"m\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"m\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eMetreE",
@@ -154,7 +198,7 @@ func TestDistance_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit prefix; valid prefixes for \"m\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"m\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"m",
@@ -188,6 +232,34 @@ func TestDistance_Set(t *testing.T) {
 			string([]byte{0x31, 0x01}),
 			"unexpected end of string",
 		},
+		{
+			"10Pnmi",
+			"unknown unit prefix; valid prefixes for \"nmi\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"5000000NM",
+			"maximum value is 9.223Gm",
+		},
+		{
+			"-1AU",
+			"minimum value is -9.223Gm",
+		},
+		{
+			"5ft 11",
+			"feet must be followed by inches, as in 5'11\"",
+		},
+		{
+			"5'-11\"",
+			"only the start of a feet and inches length can have a sign",
+		},
+		{
+			"5'11 3/2\"",
+			"invalid fraction of inch \"3/2\"",
+		},
+		{
+			"5'11 1/0\"",
+			"invalid fraction of inch \"1/0\"",
+		},
 	}
 
 	for i, tt := range succeeds {
@@ -208,6 +280,44 @@ func TestDistance_Set(t *testing.T) {
 	}
 }
 
+func TestDistance_FeetInches(t *testing.T) {
+	data := []struct {
+		in          Distance
+		denominator int64
+		expected    string
+	}{
+		{0, 16, "0'0\""},
+		{5*Foot + 11*Inch, 16, "5'11\""},
+		{5*Foot + 11*Inch + Inch/2, 16, "5'11 1/2\""},
+		{-(5*Foot + 11*Inch + Inch/2), 16, "-5'11 1/2\""},
+		{Metre, 16, "3'3 3/8\""},
+		{Metre, 32, "3'3 3/8\""},
+		{MilliMetre, 16, "0'0 1/16\""},
+		{MilliMetre, 32, "0'0 1/32\""},
+		{Foot - MilliMetre, 16, "0'11 15/16\""},
+		{Foot - MilliMetre/10, 16, "1'0\""},
+		{Metre, 1, "3'3\""},
+		{Metre, 0, "3'3\""},
+	}
+	for i, line := range data {
+		if v := line.in.FeetInches(line.denominator); v != line.expected {
+			t.Errorf("#%d: Distance(%d).FeetInches(%d) = %s != %s", i, line.in, line.denominator, v, line.expected)
+		}
+	}
+}
+
+func TestDistance_FeetInches_RoundTrip(t *testing.T) {
+	for _, x := range []Distance{0, 5*Foot + 11*Inch + Inch/2, -(2*Foot + Inch/32), 3*Foot + 3*Inch + 3*Inch/8} {
+		var y Distance
+		if err := y.Set(x.FeetInches(32)); err != nil {
+			t.Fatalf("Distance.Set(%s) failed: %v", x.FeetInches(32), err)
+		}
+		if x != y {
+			t.Fatalf("Distance expected %s to equal %s", x, y)
+		}
+	}
+}
+
 func TestDistance_RoundTrip(t *testing.T) {
 	x := 123 * Metre
 	var y Distance
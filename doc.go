@@ -10,14 +10,51 @@
 //
 // # SI units
 //
-// The supported S.I. units is a subset of the official ones.
+// All the S.I. prefixes are supported.
 //
+//	E  	exa  	10¹⁸  	1000000000000000000
+//	P  	peta 	10¹⁵  	1000000000000000
 //	T  	tera 	10¹²  	1000000000000
 //	G  	giga 	10⁹   	1000000000
 //	M  	mega 	10⁶   	1000000
 //	k  	kilo 	10³   	1000
+//	h  	hecto	10²   	100
+//	da 	deca 	10¹   	10
+//	d  	deci 	10⁻¹  	0.1
+//	c  	centi	10⁻²  	0.01
 //	m  	milli	10⁻³  	0.001
 //	µ,u	micro	10⁻⁶  	0.000001
 //	n  	nano 	10⁻⁹  	0.000000001
 //	p  	pico 	10⁻¹² 	0.000000000001
+//	f  	femto	10⁻¹⁵ 	0.000000000000001
+//	a  	atto 	10⁻¹⁸ 	0.000000000000000001
+//
+// Set only accepts the prefixes that fit the resolution and the range of the
+// type: a prefix is accepted when a value between 0.001 and 1000 of the
+// prefixed unit is representable. For example a Distance, stored in nano
+// metres, accepts "pm" to "Tm" while an ElectricalCapacitance, stored in pico
+// farads, accepts "fF" to "GF". A unit far from the base unit of its type has
+// its own range, so an Energy accepts "pJ" to "TJ" but "GeV" to "EeV". The error
+// returned for any other prefix lists the accepted ones. FormatUnit accepts all
+// the prefixes.
+//
+// Set rounds a value to the resolution of the type, so "1499fF" is 1pF, but it
+// returns an error for a nonzero value that would round to zero, such as
+// "1fF".
+//
+// # Formatting
+//
+// All the types implement fmt.Formatter. The verbs %v and %s print the same
+// string as String. A precision, as in %.6v, rounds the value to that many
+// digits after the decimal point instead of the default 3 while still
+// selecting the SI prefix automatically. A width, as in %10v, pads the result
+// with spaces on the left, or on the right with the '-' flag. The '+' flag, as
+// in %+v, appends the raw integer value in the storage unit of the type. The
+// verb %q quotes the result. Any other verb, including %d and %#v, formats the
+// raw integer value.
+//
+// FormatUnit formats a value in an explicit unit and SI prefix, for example
+// "mm" for a Distance or "F" for an ElectricalCapacitance. It accepts the same
+// units as Set, so a Distance can also be formatted in "ft" or a Temperature
+// in "°F".
 package unit
@@ -0,0 +1,251 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+// ElectricCharge is a measurement of a quantity of electricity stored as an
+// int64 nano Coulomb.
+//
+// It is the charge carried by an ElectricCurrent during a duration, commonly
+// expressed in ampere hours for the capacity of a battery.
+//
+// The highest representable value is 9.2GC.
+type ElectricCharge int64
+
+// String returns the charge formatted as a string in Coulomb.
+func (q ElectricCharge) String() string {
+	return formatSI(int64(q), nano, siFormat{}) + "C"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (q ElectricCharge) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(q), "nC", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(q), nano, siPrecision(prec)), 'C')
+	})
+}
+
+// FormatUnit returns the charge formatted in one of the units accepted by Set,
+// "C" or "Ah", with an optional SI prefix, rounded to precision digits after
+// the decimal point. A negative precision uses as many digits as necessary to
+// represent the charge exactly.
+func (q ElectricCharge) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(q), symbol, precision,
+		scaledUnit{"C", int64(Coulomb), 1},
+		scaledUnit{"Ah", int64(AmpereHour), 1},
+	)
+}
+
+// Set sets the ElectricCharge to the value represented by s. Units are to be
+// provided in "C" or "Ah" (ampere hour) with an optional SI prefix. "C" accepts
+// "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T" and "Ah"
+// accepts "f" to "G".
+func (q *ElectricCharge) Set(s string) error {
+	d, n, err := atod(s)
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s[n:], electricChargeUnits...); found != "" {
+					return err
+				}
+				return notNumberUnitErr("C or Ah")
+			case errOverflowsInt64:
+				return maxValueErr(maxElectricCharge.String())
+			case errOverflowsInt64Negative:
+				return minValueErr(minElectricCharge.String())
+			}
+		}
+		return err
+	}
+
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		var siSize int
+		si, siSize = parseSIPrefix(s[n:], prefixRange{femto, tera})
+		n += siSize
+	}
+	if r := electricChargePrefixes(s[n:]); si != unit && !r.contains(si) && slices.Contains(electricChargeUnits, s[n:]) {
+		return unknownUnitPrefixErr(s[n:], r)
+	}
+
+	switch s[n:] {
+	case "C":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minElectricCharge.String())
+			}
+			return maxValueErr(maxElectricCharge.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoCoulomb.String())
+		}
+		*q = (ElectricCharge)(v)
+		return nil
+	case "Ah":
+		// One ampere hour is 3600C.
+		v, lossy, overflow := dtoiScaled(d, decimal{base: 36, exp: 11}, si)
+		if lossy {
+			return errors.New("converting to nano coulombs would overflow, consider using nC for maximum precision")
+		}
+		if overflow {
+			if d.neg {
+				return minValueErr("-2562047Ah")
+			}
+			return maxValueErr("2562047Ah")
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoCoulomb.String())
+		}
+		*q = (ElectricCharge)(v)
+		return nil
+	case "":
+		return noUnitErr("C or Ah")
+	default:
+		if found := hasSuffixes(s[n:], electricChargeUnits...); found != "" {
+			return unknownUnitPrefixErr(found, electricChargePrefixes(found))
+		}
+		return incorrectUnitErr("C or Ah")
+	}
+}
+
+// electricChargeUnits are the units accepted by ElectricCharge.Set.
+var electricChargeUnits = []string{"C", "Ah"}
+
+// electricChargePrefixes returns the SI prefixes accepted by ElectricCharge.Set
+// before unit, those with which a value between 0.001 and 1000 of the prefixed
+// unit is representable.
+func electricChargePrefixes(unit string) prefixRange {
+	if unit == "Ah" {
+		return prefixRange{femto, giga}
+	}
+	return nanoPrefixes
+}
+
+// MarshalJSON implements json.Marshaler. The ElectricCharge is encoded as a
+// JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (q ElectricCharge) MarshalJSON() ([]byte, error) {
+	return marshalJSON(q.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano coulombs.
+func (q *ElectricCharge) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, q.Set, func(v int64) error {
+		*q = ElectricCharge(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the ElectricCharge to
+// b exactly, without the rounding of String, so that Set parses the text back
+// to the same value. It does not allocate when b has enough capacity.
+func (q ElectricCharge) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(q), nano, siFormat{mode: siExact}), 'C'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The ElectricCharge is encoded
+// as appended by AppendText.
+func (q ElectricCharge) MarshalText() ([]byte, error) {
+	return q.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (q *ElectricCharge) UnmarshalText(text []byte) error {
+	return q.Set(string(text))
+}
+
+// Value implements driver.Valuer. The ElectricCharge is stored as an integer of
+// nano coulombs.
+func (q ElectricCharge) Value() (driver.Value, error) {
+	return int64(q), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano coulombs or
+// text in a format understood by Set.
+func (q *ElectricCharge) Scan(src any) error {
+	return scanValue(src, q.Set, func(v int64) error {
+		*q = ElectricCharge(v)
+		return nil
+	})
+}
+
+// Ah returns the charge as a floating number of ampere hours.
+func (q ElectricCharge) Ah() float64 {
+	return float64(q) / float64(AmpereHour)
+}
+
+// MAh returns the charge as a floating number of milliampere hours.
+func (q ElectricCharge) MAh() float64 {
+	return float64(q) / float64(MilliAmpereHour)
+}
+
+// Mul returns the charge carried by the current c flowing during d, c×d. The
+// result saturates at the highest representable ElectricCharge.
+func (c ElectricCurrent) Mul(d time.Duration) ElectricCharge {
+	if d < 0 {
+		c, d = -c, -d
+	}
+	v, overflow := mulDiv(int64(c), int64(d), int64(time.Second))
+	if overflow {
+		if c < 0 {
+			return minElectricCharge
+		}
+		return maxElectricCharge
+	}
+	return ElectricCharge(v)
+}
+
+// Mul returns the energy of the charge q moved through the potential
+// difference v, q×v. For a battery, it is the energy stored for a capacity q at
+// a nominal voltage v. The result saturates at the highest representable
+// Energy.
+func (q ElectricCharge) Mul(v ElectricPotential) Energy {
+	if v < 0 {
+		q, v = -q, -v
+	}
+	// One nano coulomb times one nano volt is a billionth of a nano joule.
+	e, overflow := mulDiv(int64(q), int64(v), 1000000000)
+	if overflow {
+		if q < 0 {
+			return minEnergy
+		}
+		return maxEnergy
+	}
+	return Energy(e)
+}
+
+const (
+	NanoCoulomb  ElectricCharge = 1
+	MicroCoulomb ElectricCharge = 1000 * NanoCoulomb
+	MilliCoulomb ElectricCharge = 1000 * MicroCoulomb
+	Coulomb      ElectricCharge = 1000 * MilliCoulomb
+	KiloCoulomb  ElectricCharge = 1000 * Coulomb
+	MegaCoulomb  ElectricCharge = 1000 * KiloCoulomb
+	GigaCoulomb  ElectricCharge = 1000 * MegaCoulomb
+
+	// AmpereHour is the charge of one Ampere flowing during one hour, 3600C.
+	MilliAmpereHour ElectricCharge = 3600 * MilliCoulomb
+	AmpereHour      ElectricCharge = 1000 * MilliAmpereHour
+
+	maxElectricCharge ElectricCharge = (1 << 63) - 1
+	minElectricCharge ElectricCharge = -((1 << 63) - 1)
+)
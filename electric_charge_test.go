@@ -0,0 +1,228 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestElectricCharge_String(t *testing.T) {
+	if s := NanoCoulomb.String(); s != "1nC" {
+		t.Fatalf("%v", s)
+	}
+	if s := MicroCoulomb.String(); s != "1µC" {
+		t.Fatalf("%v", s)
+	}
+	if s := MilliCoulomb.String(); s != "1mC" {
+		t.Fatalf("%v", s)
+	}
+	if s := Coulomb.String(); s != "1C" {
+		t.Fatalf("%v", s)
+	}
+	if s := KiloCoulomb.String(); s != "1kC" {
+		t.Fatalf("%v", s)
+	}
+	if s := MegaCoulomb.String(); s != "1MC" {
+		t.Fatalf("%v", s)
+	}
+	if s := GigaCoulomb.String(); s != "1GC" {
+		t.Fatalf("%v", s)
+	}
+	if s := MilliAmpereHour.String(); s != "3.600C" {
+		t.Fatalf("%v", s)
+	}
+	if s := AmpereHour.String(); s != "3.600kC" {
+		t.Fatalf("%v", s)
+	}
+}
+
+func TestElectricCharge_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected ElectricCharge
+	}{
+		{"1nC", 1 * NanoCoulomb},
+		{"10nC", 10 * NanoCoulomb},
+		{"1uC", 1 * MicroCoulomb},
+		{"1µC", 1 * MicroCoulomb},
+		{"1mC", 1 * MilliCoulomb},
+		{"1C", 1 * Coulomb},
+		{"10C", 10 * Coulomb},
+		{"1kC", 1 * KiloCoulomb},
+		{"1MC", 1 * MegaCoulomb},
+		{"1GC", 1 * GigaCoulomb},
+		{"12.345C", 12345 * MilliCoulomb},
+		{"-12.345C", -12345 * MilliCoulomb},
+		{"9.223372036854775807GC", 9223372036854775807 * NanoCoulomb},
+		{"-9.223372036854775807GC", -9223372036854775807 * NanoCoulomb},
+		{"1Ah", 1 * AmpereHour},
+		{"1mAh", 1 * MilliAmpereHour},
+		{"1000fAh", 4 * NanoCoulomb},
+		{"3000mAh", 10800 * Coulomb},
+		{"1.5Ah", 5400 * Coulomb},
+		{"-2Ah", -7200 * Coulomb},
+		{"1kAh", 3600 * KiloCoulomb},
+		{"1µAh", 3600 * MicroCoulomb},
+		{"2562047Ah", 9223369200000000000 * NanoCoulomb},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10TC",
+			"maximum value is 9.223GC",
+		},
+		{
+			"10EC",
+			"unknown unit prefix; valid prefixes for \"C\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10EAh",
+			"unknown unit prefix; valid prefixes for \"Ah\" are f,p,n,u,µ,m,c,d,da,h,k,M or G",
+		},
+		{
+			"1TAh",
+			"unknown unit prefix; valid prefixes for \"Ah\" are f,p,n,u,µ,m,c,d,da,h,k,M or G",
+		},
+		{
+			"10",
+			"no unit provided; need C or Ah",
+		},
+		{
+			"1random",
+			"unknown unit provided; need C or Ah",
+		},
+		{
+			"1A",
+			"unknown unit provided; need C or Ah",
+		},
+		{
+			"9223372036854775808",
+			"maximum value is 9.223GC",
+		},
+		{
+			"-9223372036854775808",
+			"minimum value is -9.223GC",
+		},
+		{
+			"2562048Ah",
+			"maximum value is 2562047Ah",
+		},
+		{
+			"-2562048Ah",
+			"minimum value is -2562047Ah",
+		},
+		{
+			"C",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit C or Ah",
+		},
+		{
+			"++1C",
+			"contains multiple plus symbols",
+		},
+		{
+			"1.1.1.1Ah",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got ElectricCharge
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: ElectricCharge.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: ElectricCharge.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got ElectricCharge
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: ElectricCharge.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestElectricCharge_Ah(t *testing.T) {
+	if v := ElectricCharge(123 * AmpereHour).Ah(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestElectricCharge_MAh(t *testing.T) {
+	if v := ElectricCharge(3000 * MilliAmpereHour).MAh(); v != 3000. {
+		t.Fatal(v)
+	}
+}
+
+func TestElectricCurrent_Mul(t *testing.T) {
+	data := []struct {
+		c    ElectricCurrent
+		d    time.Duration
+		want ElectricCharge
+	}{
+		{2 * Ampere, 90 * time.Minute, 10800 * Coulomb},
+		{Ampere, time.Hour, AmpereHour},
+		{MilliAmpere, time.Hour, MilliAmpereHour},
+		{500 * MilliAmpere, -time.Hour, -1800 * Coulomb},
+		{-500 * MilliAmpere, -time.Hour, 1800 * Coulomb},
+		{Ampere, time.Nanosecond, NanoCoulomb},
+		{Ampere, 0, 0},
+		{GigaAmpere, 10 * time.Second, maxElectricCharge},
+		{-GigaAmpere, 10 * time.Second, minElectricCharge},
+	}
+	for i, tt := range data {
+		if got := tt.c.Mul(tt.d); got != tt.want {
+			t.Errorf("#%d: %s.Mul(%s) expected: %s(%d) but got: %s(%d)", i, tt.c, tt.d, tt.want, tt.want, got, got)
+		}
+	}
+}
+
+func TestElectricCharge_Mul(t *testing.T) {
+	data := []struct {
+		q    ElectricCharge
+		v    ElectricPotential
+		want Energy
+	}{
+		{Coulomb, Volt, Joule},
+		{3000 * MilliAmpereHour, 3700 * MilliVolt, 39960 * Joule},
+		{AmpereHour, 12 * Volt, 12 * WattHour},
+		{-Coulomb, Volt, -Joule},
+		{Coulomb, -Volt, -Joule},
+		{-Coulomb, -Volt, Joule},
+		{NanoCoulomb, NanoVolt, 0},
+		{GigaCoulomb, 10 * Volt, maxEnergy},
+		{-GigaCoulomb, 10 * Volt, minEnergy},
+	}
+	for i, tt := range data {
+		if got := tt.q.Mul(tt.v); got != tt.want {
+			t.Errorf("#%d: %s.Mul(%s) expected: %s(%d) but got: %s(%d)", i, tt.q, tt.v, tt.want, tt.want, got, got)
+		}
+	}
+	if s, err := (3000 * MilliAmpereHour).Mul(3700*MilliVolt).FormatUnit("Wh", 1); err != nil || s != "11.1Wh" {
+		t.Fatalf("%s %v", s, err)
+	}
+}
+
+func TestElectricCharge_RoundTrip(t *testing.T) {
+	x := 3600 * KiloCoulomb
+	var y ElectricCharge
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("ElectricCharge.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("ElectricCharge expected %s to equal %s", x, y)
+	}
+}
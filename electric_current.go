@@ -6,6 +6,11 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
 // ElectricCurrent is a measurement of a flow of electric charge stored as an
 // int64 nano Ampere.
 //
@@ -16,14 +21,30 @@ type ElectricCurrent int64
 
 // String returns the current formatted as a string in Ampere.
 func (c ElectricCurrent) String() string {
-	return nanoAsString(int64(c)) + "A"
+	return formatSI(int64(c), nano, siFormat{}) + "A"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (c ElectricCurrent) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(c), "nA", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(c), nano, siPrecision(prec)), 'A')
+	})
+}
+
+// FormatUnit returns the current formatted in the unit symbol "A" with an
+// optional SI prefix, rounded to precision digits after the decimal point. A
+// negative precision uses as many digits as necessary to represent the current
+// exactly.
+func (c ElectricCurrent) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(c), symbol, precision, scaledUnit{"A", int64(Ampere), 1})
 }
 
-// Set sets the ElectricCurrent to the value represented by s. Units are to
-// be provided in "A" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// Set sets the ElectricCurrent to the value represented by s. Units are to be
+// provided in "A" with an optional SI prefix: "p", "n", "u", "µ", "m", "c",
+// "d", "da", "h", "k", "M", "G" or "T".
 func (c *ElectricCurrent) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	v, n, err := valueOfUnitString(s, nano, nanoPrefixes, "A", "a")
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
@@ -36,6 +57,8 @@ func (c *ElectricCurrent) Set(s string) error {
 				return maxValueErr(maxElectricCurrent.String())
 			case errOverflowsInt64Negative:
 				return minValueErr(minElectricCurrent.String())
+			case errRoundsToZero:
+				return resolutionErr(NanoAmpere.String())
 			}
 		}
 		return err
@@ -48,7 +71,7 @@ func (c *ElectricCurrent) Set(s string) error {
 		return noUnitErr("A")
 	default:
 		if found := hasSuffixes(s[n:], "A"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
 		return incorrectUnitErr("A")
 	}
@@ -56,6 +79,55 @@ func (c *ElectricCurrent) Set(s string) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The ElectricCurrent is encoded as a
+// JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (c ElectricCurrent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(c.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano amperes.
+func (c *ElectricCurrent) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, c.Set, func(v int64) error {
+		*c = ElectricCurrent(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the ElectricCurrent
+// to b exactly, without the rounding of String, so that Set parses the text
+// back to the same value. It does not allocate when b has enough capacity.
+func (c ElectricCurrent) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(c), nano, siFormat{mode: siExact}), 'A'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The ElectricCurrent is encoded
+// as appended by AppendText.
+func (c ElectricCurrent) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (c *ElectricCurrent) UnmarshalText(text []byte) error {
+	return c.Set(string(text))
+}
+
+// Value implements driver.Valuer. The ElectricCurrent is stored as an integer
+// of nano amperes.
+func (c ElectricCurrent) Value() (driver.Value, error) {
+	return int64(c), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano amperes or
+// text in a format understood by Set.
+func (c *ElectricCurrent) Scan(src any) error {
+	return scanValue(src, c.Set, func(v int64) error {
+		*c = ElectricCurrent(v)
+		return nil
+	})
+}
+
 const (
 	NanoAmpere  ElectricCurrent = 1
 	MicroAmpere ElectricCurrent = 1000 * NanoAmpere
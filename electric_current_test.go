@@ -60,7 +60,7 @@ func TestElectricCurrent_Set(t *testing.T) {
 		},
 		{
 			"10EA",
-			"unknown unit prefix; valid prefixes for \"A\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"A\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eAmpE",
@@ -6,6 +6,11 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
 // ElectricPotential is a measurement of electric potential stored as an int64
 // nano Volt.
 //
@@ -14,14 +19,30 @@ type ElectricPotential int64
 
 // String returns the tension formatted as a string in Volt.
 func (p ElectricPotential) String() string {
-	return nanoAsString(int64(p)) + "V"
+	return formatSI(int64(p), nano, siFormat{}) + "V"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (p ElectricPotential) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(p), "nV", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(p), nano, siPrecision(prec)), 'V')
+	})
+}
+
+// FormatUnit returns the tension formatted in the unit symbol "V" with an
+// optional SI prefix, rounded to precision digits after the decimal point. A
+// negative precision uses as many digits as necessary to represent the tension
+// exactly.
+func (p ElectricPotential) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(p), symbol, precision, scaledUnit{"V", int64(Volt), 1})
 }
 
-// Set sets the ElectricPotential to the value represented by s. Units are to
-// be provided in "V" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// Set sets the ElectricPotential to the value represented by s. Units are to be
+// provided in "V" with an optional SI prefix: "p", "n", "u", "µ", "m", "c",
+// "d", "da", "h", "k", "M", "G" or "T".
 func (p *ElectricPotential) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	v, n, err := valueOfUnitString(s, nano, nanoPrefixes, "V", "v")
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
@@ -34,6 +55,8 @@ func (p *ElectricPotential) Set(s string) error {
 				return maxValueErr(maxElectricPotential.String())
 			case errOverflowsInt64Negative:
 				return minValueErr(minElectricPotential.String())
+			case errRoundsToZero:
+				return resolutionErr(NanoVolt.String())
 			}
 		}
 		return err
@@ -45,13 +68,62 @@ func (p *ElectricPotential) Set(s string) error {
 		return noUnitErr("V")
 	default:
 		if found := hasSuffixes(s[n:], "V", "v"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
 		return incorrectUnitErr("V")
 	}
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The ElectricPotential is encoded as a
+// JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (p ElectricPotential) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano volts.
+func (p *ElectricPotential) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, p.Set, func(v int64) error {
+		*p = ElectricPotential(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the ElectricPotential
+// to b exactly, without the rounding of String, so that Set parses the text
+// back to the same value. It does not allocate when b has enough capacity.
+func (p ElectricPotential) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(p), nano, siFormat{mode: siExact}), 'V'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The ElectricPotential is
+// encoded as appended by AppendText.
+func (p ElectricPotential) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (p *ElectricPotential) UnmarshalText(text []byte) error {
+	return p.Set(string(text))
+}
+
+// Value implements driver.Valuer. The ElectricPotential is stored as an integer
+// of nano volts.
+func (p ElectricPotential) Value() (driver.Value, error) {
+	return int64(p), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano volts or
+// text in a format understood by Set.
+func (p *ElectricPotential) Scan(src any) error {
+	return scanValue(src, p.Set, func(v int64) error {
+		*p = ElectricPotential(v)
+		return nil
+	})
+}
+
 const (
 	// Volt is W/A, kg⋅m²/s³/A.
 	NanoVolt  ElectricPotential = 1
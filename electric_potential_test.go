@@ -60,7 +60,7 @@ func TestElectricPotential_Set(t *testing.T) {
 		},
 		{
 			"10EV",
-			"unknown unit prefix; valid prefixes for \"V\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"V\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eVoltE",
@@ -6,6 +6,11 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
 // ElectricResistance is a measurement of the difficulty to pass an electric
 // current through a conductor stored as an int64 nano Ohm.
 //
@@ -14,14 +19,34 @@ type ElectricResistance int64
 
 // String returns the resistance formatted as a string in Ohm.
 func (r ElectricResistance) String() string {
-	return nanoAsString(int64(r)) + "Ω"
+	return formatSI(int64(r), nano, siFormat{}) + "Ω"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (r ElectricResistance) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(r), "nΩ", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(r), nano, siPrecision(prec)), "Ω"...)
+	})
+}
+
+// FormatUnit returns the resistance formatted in one of the unit symbols "Ω",
+// "Ohm" or "ohm" with an optional SI prefix, rounded to precision digits after
+// the decimal point. A negative precision uses as many digits as necessary to
+// represent the resistance exactly.
+func (r ElectricResistance) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(r), symbol, precision,
+		scaledUnit{"Ω", int64(Ohm), 1},
+		scaledUnit{"Ohm", int64(Ohm), 1},
+		scaledUnit{"ohm", int64(Ohm), 1},
+	)
 }
 
 // Set sets the ElectricResistance to the value represented by s. Units are to
 // be provided in "Ohm", or "Ω" with an optional SI prefix: "p", "n", "u", "µ",
-// "m", "k", "M", "G" or "T".
+// "m", "c", "d", "da", "h", "k", "M", "G" or "T".
 func (r *ElectricResistance) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	v, n, err := valueOfUnitString(s, nano, nanoPrefixes, "Ohm", "ohm", "Ω")
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
@@ -34,6 +59,8 @@ func (r *ElectricResistance) Set(s string) error {
 				return maxValueErr(maxElectricResistance.String())
 			case errOverflowsInt64Negative:
 				return minValueErr(minElectricResistance.String())
+			case errRoundsToZero:
+				return resolutionErr(NanoOhm.String())
 			}
 		}
 		return err
@@ -46,13 +73,63 @@ func (r *ElectricResistance) Set(s string) error {
 		return noUnitErr("Ohm or Ω")
 	default:
 		if found := hasSuffixes(s[n:], "Ohm", "ohm", "Ω"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
 		return incorrectUnitErr("Ohm or Ω")
 	}
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The ElectricResistance is encoded as a
+// JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (r ElectricResistance) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano ohms.
+func (r *ElectricResistance) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, r.Set, func(v int64) error {
+		*r = ElectricResistance(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the
+// ElectricResistance to b exactly, without the rounding of String, so that Set
+// parses the text back to the same value. It does not allocate when b has
+// enough capacity.
+func (r ElectricResistance) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(r), nano, siFormat{mode: siExact}), "Ω"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The ElectricResistance is
+// encoded as appended by AppendText.
+func (r ElectricResistance) MarshalText() ([]byte, error) {
+	return r.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (r *ElectricResistance) UnmarshalText(text []byte) error {
+	return r.Set(string(text))
+}
+
+// Value implements driver.Valuer. The ElectricResistance is stored as an
+// integer of nano ohms.
+func (r ElectricResistance) Value() (driver.Value, error) {
+	return int64(r), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano ohms or
+// text in a format understood by Set.
+func (r *ElectricResistance) Scan(src any) error {
+	return scanValue(src, r.Set, func(v int64) error {
+		*r = ElectricResistance(v)
+		return nil
+	})
+}
+
 const (
 	// Ohm is V/A, kg⋅m²/s³/A².
 	NanoOhm  ElectricResistance = 1
@@ -59,11 +59,11 @@ func TestElectricResistance_Set(t *testing.T) {
 		},
 		{
 			"10EOhm",
-			"unknown unit prefix; valid prefixes for \"Ohm\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"Ohm\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10ExaOhm",
-			"unknown unit prefix; valid prefixes for \"Ohm\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"Ohm\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eOhmE",
@@ -6,6 +6,11 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
 // ElectricalCapacitance is a measurement of capacitance stored as a pico farad.
 //
 // The highest representable value is 9.2MF.
@@ -13,14 +18,30 @@ type ElectricalCapacitance int64
 
 // String returns the energy formatted as a string in Farad.
 func (c ElectricalCapacitance) String() string {
-	return picoAsString(int64(c)) + "F"
+	return formatSI(int64(c), pico, siFormat{}) + "F"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (c ElectricalCapacitance) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(c), "pF", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(c), pico, siPrecision(prec)), 'F')
+	})
+}
+
+// FormatUnit returns the capacitance formatted in the unit symbol "F" with an
+// optional SI prefix, rounded to precision digits after the decimal point. A
+// negative precision uses as many digits as necessary to represent the
+// capacitance exactly.
+func (c ElectricalCapacitance) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(c), symbol, precision, scaledUnit{"F", int64(Farad), 1})
 }
 
 // Set sets the ElectricalCapacitance to the value represented by s. Units are
-// to be provided in "F" with an optional SI prefix: "p", "n", "u", "µ", "m",
-// "k", "M", "G" or "T".
+// to be provided in "F" with an optional SI prefix: "f", "p", "n", "u", "µ",
+// "m", "c", "d", "da", "h", "k", "M" or "G".
 func (c *ElectricalCapacitance) Set(s string) error {
-	v, n, err := valueOfUnitString(s, pico)
+	v, n, err := valueOfUnitString(s, pico, picoPrefixes, "F", "f")
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
@@ -33,6 +54,8 @@ func (c *ElectricalCapacitance) Set(s string) error {
 				return maxValueErr(maxElectricalCapacitance.String())
 			case errOverflowsInt64Negative:
 				return minValueErr(minElectricalCapacitance.String())
+			case errRoundsToZero:
+				return resolutionErr(PicoFarad.String())
 			}
 		}
 		return err
@@ -45,7 +68,7 @@ func (c *ElectricalCapacitance) Set(s string) error {
 		return noUnitErr("F")
 	default:
 		if found := hasSuffixes(s[n:], "F", "f"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+			return unknownUnitPrefixErr(found, picoPrefixes)
 		}
 		return incorrectUnitErr("F")
 	}
@@ -53,6 +76,56 @@ func (c *ElectricalCapacitance) Set(s string) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The ElectricalCapacitance is encoded
+// as a JSON string of the text appended by AppendText, which UnmarshalJSON
+// parses back to the same value.
+func (c ElectricalCapacitance) MarshalJSON() ([]byte, error) {
+	return marshalJSON(c.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of pico farads.
+func (c *ElectricalCapacitance) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, c.Set, func(v int64) error {
+		*c = ElectricalCapacitance(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the
+// ElectricalCapacitance to b exactly, without the rounding of String, so that
+// Set parses the text back to the same value. It does not allocate when b has
+// enough capacity.
+func (c ElectricalCapacitance) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(c), pico, siFormat{mode: siExact}), 'F'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The ElectricalCapacitance is
+// encoded as appended by AppendText.
+func (c ElectricalCapacitance) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (c *ElectricalCapacitance) UnmarshalText(text []byte) error {
+	return c.Set(string(text))
+}
+
+// Value implements driver.Valuer. The ElectricalCapacitance is stored as an
+// integer of pico farads.
+func (c ElectricalCapacitance) Value() (driver.Value, error) {
+	return int64(c), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of pico farads or
+// text in a format understood by Set.
+func (c *ElectricalCapacitance) Scan(src any) error {
+	return scanValue(src, c.Set, func(v int64) error {
+		*c = ElectricalCapacitance(v)
+		return nil
+	})
+}
+
 const (
 	// Farad is a unit of capacitance. kg⁻¹⋅m⁻²⋅s⁴A²
 	PicoFarad  ElectricalCapacitance = 1
@@ -54,6 +54,11 @@ func TestElectricalCapacitance_Set(t *testing.T) {
 		{"100mF", 100 * MilliFarad},
 		{"1F", 1 * Farad},
 		{"1f", 1 * Farad},
+		{"1000fF", 1 * PicoFarad},
+		{"1499fF", 1 * PicoFarad},
+		{"500fF", 1 * PicoFarad},
+		{"0fF", 0},
+		{"1cF", 10 * MilliFarad},
 		{"10F", 10 * Farad},
 		{"100F", 100 * Farad},
 		{"1kF", 1 * KiloFarad},
@@ -73,16 +78,28 @@ func TestElectricalCapacitance_Set(t *testing.T) {
 		err string
 	}{
 		{
-			"10TF",
+			"10GF",
 			"maximum value is 9.223MF",
 		},
+		{
+			"1fF",
+			"nonzero value rounds to zero; resolution is 1pF",
+		},
+		{
+			"-499fF",
+			"nonzero value rounds to zero; resolution is 1pF",
+		},
+		{
+			"10TF",
+			"unknown unit prefix; valid prefixes for \"F\" are f,p,n,u,µ,m,c,d,da,h,k,M or G",
+		},
 		{
 			"10EF",
-			"unknown unit prefix; valid prefixes for \"F\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"F\" are f,p,n,u,µ,m,c,d,da,h,k,M or G",
 		},
 		{
 			"10ExaF",
-			"unknown unit prefix; valid prefixes for \"F\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"F\" are f,p,n,u,µ,m,c,d,da,h,k,M or G",
 		},
 		{
 			"10eFaradE",
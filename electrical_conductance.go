@@ -0,0 +1,193 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// ElectricalConductance is a measurement of the ease with which an electric
+// current passes through a conductor stored as an int64 nano Siemens. It is the
+// reciprocal of ElectricResistance.
+//
+// The highest representable value is 9.2GS.
+type ElectricalConductance int64
+
+// String returns the conductance formatted as a string in Siemens.
+func (g ElectricalConductance) String() string {
+	return formatSI(int64(g), nano, siFormat{}) + "S"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (g ElectricalConductance) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(g), "nS", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(g), nano, siPrecision(prec)), 'S')
+	})
+}
+
+// FormatUnit returns the conductance formatted in one of the unit symbols "S"
+// or "mho" with an optional SI prefix, rounded to precision digits after the
+// decimal point. A negative precision uses as many digits as necessary to
+// represent the conductance exactly.
+func (g ElectricalConductance) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(g), symbol, precision,
+		scaledUnit{"S", int64(Siemens), 1},
+		scaledUnit{"mho", int64(Siemens), 1},
+	)
+}
+
+// Set sets the ElectricalConductance to the value represented by s. Units are
+// to be provided in "S" or "mho" with an optional SI prefix: "p", "n", "u",
+// "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T". A lone "mho" is one
+// siemens and not a milli prefix.
+func (g *ElectricalConductance) Set(s string) error {
+	v, n, err := valueOfUnitString(s, nano, nanoPrefixes, "S", "mho")
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s, "S", "mho"); found != "" {
+					return err
+				}
+				return notNumberUnitErr("S or mho")
+			case errOverflowsInt64:
+				return maxValueErr(maxElectricalConductance.String())
+			case errOverflowsInt64Negative:
+				return minValueErr(minElectricalConductance.String())
+			case errRoundsToZero:
+				return resolutionErr(NanoSiemens.String())
+			}
+		}
+		return err
+	}
+
+	switch s[n:] {
+	case "S", "mho":
+		*g = (ElectricalConductance)(v)
+	case "":
+		return noUnitErr("S or mho")
+	default:
+		if found := hasSuffixes(s[n:], "S", "mho"); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("S or mho")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The ElectricalConductance is encoded
+// as a JSON string of the text appended by AppendText, which UnmarshalJSON
+// parses back to the same value.
+func (g ElectricalConductance) MarshalJSON() ([]byte, error) {
+	return marshalJSON(g.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano siemens.
+func (g *ElectricalConductance) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, g.Set, func(v int64) error {
+		*g = ElectricalConductance(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the
+// ElectricalConductance to b exactly, without the rounding of String, so that
+// Set parses the text back to the same value. It does not allocate when b has
+// enough capacity.
+func (g ElectricalConductance) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(g), nano, siFormat{mode: siExact}), 'S'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The ElectricalConductance is
+// encoded as appended by AppendText.
+func (g ElectricalConductance) MarshalText() ([]byte, error) {
+	return g.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (g *ElectricalConductance) UnmarshalText(text []byte) error {
+	return g.Set(string(text))
+}
+
+// Value implements driver.Valuer. The ElectricalConductance is stored as an
+// integer of nano siemens.
+func (g ElectricalConductance) Value() (driver.Value, error) {
+	return int64(g), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano siemens or
+// text in a format understood by Set.
+func (g *ElectricalConductance) Scan(src any) error {
+	return scanValue(src, g.Set, func(v int64) error {
+		*g = ElectricalConductance(v)
+		return nil
+	})
+}
+
+// Resistance returns the resistance of a conductor of this conductance, 1/g,
+// rounded to the nearest nano ohm.
+//
+// A 0S conductance has an infinite resistance, so the result saturates at the
+// highest representable ElectricResistance.
+func (g ElectricalConductance) Resistance() ElectricResistance {
+	if g == 0 {
+		return maxElectricResistance
+	}
+	// One siemens is the reciprocal of one ohm, so the product of a
+	// resistance in nano ohms and a conductance in nano siemens is 10¹⁸. It
+	// cannot overflow.
+	neg := g < 0
+	if neg {
+		g = -g
+	}
+	v, _ := mulDiv(int64(Ohm), int64(Siemens), int64(g))
+	if neg {
+		return -ElectricResistance(v)
+	}
+	return ElectricResistance(v)
+}
+
+// Conductance returns the conductance of a conductor of this resistance, 1/r,
+// rounded to the nearest nano siemens.
+//
+// A 0Ω resistance has an infinite conductance, so the result saturates at the
+// highest representable ElectricalConductance.
+func (r ElectricResistance) Conductance() ElectricalConductance {
+	if r == 0 {
+		return maxElectricalConductance
+	}
+	neg := r < 0
+	if neg {
+		r = -r
+	}
+	v, _ := mulDiv(int64(Ohm), int64(Siemens), int64(r))
+	if neg {
+		return -ElectricalConductance(v)
+	}
+	return ElectricalConductance(v)
+}
+
+const (
+	// Siemens is A/V, the reciprocal of Ohm. kg⁻¹⋅m⁻²⋅s³⋅A²
+	NanoSiemens  ElectricalConductance = 1
+	MicroSiemens ElectricalConductance = 1000 * NanoSiemens
+	MilliSiemens ElectricalConductance = 1000 * MicroSiemens
+	Siemens      ElectricalConductance = 1000 * MilliSiemens
+	KiloSiemens  ElectricalConductance = 1000 * Siemens
+	MegaSiemens  ElectricalConductance = 1000 * KiloSiemens
+	GigaSiemens  ElectricalConductance = 1000 * MegaSiemens
+
+	// Mho is the older name of Siemens.
+	Mho = Siemens
+
+	maxElectricalConductance = 9223372036854775807 * NanoSiemens
+	minElectricalConductance = -9223372036854775807 * NanoSiemens
+)
@@ -0,0 +1,185 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import "testing"
+
+func TestElectricalConductance_String(t *testing.T) {
+	if s := NanoSiemens.String(); s != "1nS" {
+		t.Fatalf("%v", s)
+	}
+	if s := MicroSiemens.String(); s != "1µS" {
+		t.Fatalf("%v", s)
+	}
+	if s := MilliSiemens.String(); s != "1mS" {
+		t.Fatalf("%v", s)
+	}
+	if s := Siemens.String(); s != "1S" {
+		t.Fatalf("%v", s)
+	}
+	if s := KiloSiemens.String(); s != "1kS" {
+		t.Fatalf("%v", s)
+	}
+	if s := MegaSiemens.String(); s != "1MS" {
+		t.Fatalf("%v", s)
+	}
+	if s := GigaSiemens.String(); s != "1GS" {
+		t.Fatalf("%v", s)
+	}
+}
+
+func TestElectricalConductance_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected ElectricalConductance
+	}{
+		{"1nS", 1 * NanoSiemens},
+		{"10nS", 10 * NanoSiemens},
+		{"1uS", 1 * MicroSiemens},
+		{"1µS", 1 * MicroSiemens},
+		{"1mS", 1 * MilliSiemens},
+		{"1S", 1 * Siemens},
+		{"10S", 10 * Siemens},
+		{"1kS", 1 * KiloSiemens},
+		{"1MS", 1 * MegaSiemens},
+		{"1GS", 1 * GigaSiemens},
+		{"12.345S", 12345 * MilliSiemens},
+		{"-12.345S", -12345 * MilliSiemens},
+		{"9.223372036854775807GS", 9223372036854775807 * NanoSiemens},
+		{"-9.223372036854775807GS", -9223372036854775807 * NanoSiemens},
+		{"1mho", 1 * Mho},
+		{"2.5mho", 2500 * MilliSiemens},
+		{"1mmho", 1 * MilliSiemens},
+		{"1µmho", 1 * MicroSiemens},
+		{"1kmho", 1 * KiloSiemens},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10TS",
+			"maximum value is 9.223GS",
+		},
+		{
+			"10ES",
+			"unknown unit prefix; valid prefixes for \"S\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10Emho",
+			"unknown unit prefix; valid prefixes for \"mho\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10",
+			"no unit provided; need S or mho",
+		},
+		{
+			"9223372036854775808",
+			"maximum value is 9.223GS",
+		},
+		{
+			"-9223372036854775808",
+			"minimum value is -9.223GS",
+		},
+		{
+			"1random",
+			"unknown unit provided; need S or mho",
+		},
+		{
+			"1Ohm",
+			"unknown unit provided; need S or mho",
+		},
+		{
+			"S",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit S or mho",
+		},
+		{
+			"++1S",
+			"contains multiple plus symbols",
+		},
+		{
+			"1.1.1.1mho",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got ElectricalConductance
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: ElectricalConductance.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: ElectricalConductance.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got ElectricalConductance
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: ElectricalConductance.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestElectricalConductance_Resistance(t *testing.T) {
+	data := []struct {
+		in   ElectricalConductance
+		want ElectricResistance
+	}{
+		{Siemens, Ohm},
+		{MilliSiemens, KiloOhm},
+		{100 * MicroSiemens, 10 * KiloOhm},
+		{3 * Siemens, 333333333 * NanoOhm},
+		{-2 * Siemens, -500 * MilliOhm},
+		{NanoSiemens, GigaOhm},
+		{GigaSiemens, NanoOhm},
+		{0, maxElectricResistance},
+	}
+	for i, tt := range data {
+		if got := tt.in.Resistance(); got != tt.want {
+			t.Errorf("#%d: %s.Resistance() expected: %s(%d) but got: %s(%d)", i, tt.in, tt.want, tt.want, got, got)
+		}
+	}
+}
+
+func TestElectricResistance_Conductance(t *testing.T) {
+	data := []struct {
+		in   ElectricResistance
+		want ElectricalConductance
+	}{
+		{Ohm, Siemens},
+		{KiloOhm, MilliSiemens},
+		{10 * KiloOhm, 100 * MicroSiemens},
+		{3 * Ohm, 333333333 * NanoSiemens},
+		{-2 * Ohm, -500 * MilliSiemens},
+		{NanoOhm, GigaSiemens},
+		{GigaOhm, NanoSiemens},
+		{3 * GigaOhm, 0},
+		{0, maxElectricalConductance},
+	}
+	for i, tt := range data {
+		if got := tt.in.Conductance(); got != tt.want {
+			t.Errorf("#%d: %s.Conductance() expected: %s(%d) but got: %s(%d)", i, tt.in, tt.want, tt.want, got, got)
+		}
+	}
+}
+
+func TestElectricalConductance_RoundTrip(t *testing.T) {
+	x := 250 * MicroSiemens
+	var y ElectricalConductance
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("ElectricalConductance.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("ElectricalConductance expected %s to equal %s", x, y)
+	}
+}
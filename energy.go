@@ -6,6 +6,14 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
 // Energy is a measurement of work stored as a nano joules.
 //
 // The highest representable value is 9.2GJ.
@@ -13,22 +21,50 @@ type Energy int64
 
 // String returns the energy formatted as a string in Joules.
 func (e Energy) String() string {
-	return nanoAsString(int64(e)) + "J"
+	return formatSI(int64(e), nano, siFormat{}) + "J"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (e Energy) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(e), "nJ", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(e), nano, siPrecision(prec)), 'J')
+	})
 }
 
-// Set sets the Energy to the value represented by s. Units are to
-// be provided in "J" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// FormatUnit returns the energy formatted in one of the units accepted by Set,
+// "J", "Wh", "BTU", "cal" or "eV", with an optional SI prefix, rounded to
+// precision digits after the decimal point. A negative precision uses as many
+// digits as necessary to represent the energy exactly.
+func (e Energy) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(e), symbol, precision,
+		scaledUnit{"J", int64(Joule), 1},
+		scaledUnit{"Wh", int64(WattHour), 1},
+		scaledUnit{"BTU", int64(BTU), 1},
+		scaledUnit{"cal", int64(Calorie), 1},
+		// One electronvolt is exactly 1.602176634e-10nJ.
+		scaledUnit{"eV", 801088317, 5000000000000000000},
+	)
+}
+
+// Set sets the Energy to the value represented by s. Units are to be provided
+// in "J", "Wh", "BTU", "cal" or "eV" with an optional SI prefix. "J" and "cal"
+// accept "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T",
+// "Wh" and "BTU" accept "f" to "G" and "eV" accepts "G", "T", "P" or "E". The
+// highest representable value is 9.2GJ, so "PJ" is not accepted.
+//
+// Energy has a resolution of 1nJ, about 6.24GeV, so a nonzero value below about
+// 3.1GeV, which would round to zero, is an error.
 func (e *Energy) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s, "J", "j"); found != "" {
+				if found := hasSuffixes(s[n:], energyUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("J")
+				return notNumberUnitErr("J, Wh, BTU, cal or eV")
 			case errOverflowsInt64:
 				return maxValueErr(maxEnergy.String())
 			case errOverflowsInt64Negative:
@@ -38,21 +74,178 @@ func (e *Energy) Set(s string) error {
 		return err
 	}
 
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "J", "j", "Wh", "BTU", "cal", "eV":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], prefixRange{femto, exa})
+			n += siSize
+		}
+	}
+	if r := energyPrefixes(s[n:]); si != unit && !r.contains(si) && slices.Contains(energyUnits, s[n:]) {
+		return unknownUnitPrefixErr(s[n:], r)
+	}
+
+	// factor is the value of the unit in nano joules and maxUnit is the largest
+	// representable value, formatted in the unit when it is short enough.
+	var factor decimal
+	var maxUnit string
 	switch s[n:] {
 	case "J", "j":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minEnergy.String())
+			}
+			return maxValueErr(maxEnergy.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoJoule.String())
+		}
 		*e = (Energy)(v)
+		return nil
+	case "Wh":
+		factor, maxUnit = decimal{base: 36, exp: 11}, "2562047Wh"
+	case "BTU":
+		factor, maxUnit = decimal{base: 105506, exp: 7}, "8742035BTU"
+	case "cal":
+		factor, maxUnit = decimal{base: 4184, exp: 6}, "2204438823cal"
+	case "eV":
+		// The limit, about 5.76×10²⁸eV, has no short form in eV.
+		factor, maxUnit = decimal{base: 1602176634, exp: -19}, maxEnergy.String()
 	case "":
-		return noUnitErr("J")
+		return noUnitErr("J, Wh, BTU, cal or eV")
 	default:
-		if found := hasSuffixes(s[n:], "J", "j"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], energyUnits...); found != "" {
+			return unknownUnitPrefixErr(found, energyPrefixes(found))
 		}
-		return incorrectUnitErr("J")
+		return incorrectUnitErr("J, Wh, BTU, cal or eV")
 	}
-
+	v, lossy, overflow := dtoiScaled(d, factor, si)
+	if lossy {
+		return errors.New("converting to nano joules would overflow, consider using nJ for maximum precision")
+	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + maxUnit)
+		}
+		return maxValueErr(maxUnit)
+	}
+	if roundsToZero(d, v) {
+		return resolutionErr(NanoJoule.String())
+	}
+	*e = (Energy)(v)
 	return nil
 }
 
+// energyUnits are the units accepted by Energy.Set.
+var energyUnits = []string{"J", "j", "Wh", "BTU", "cal", "eV"}
+
+// energyPrefixes returns the SI prefixes accepted by Energy.Set before unit,
+// those with which a value between 0.001 and 1000 of the prefixed unit is
+// representable.
+func energyPrefixes(unit string) prefixRange {
+	switch unit {
+	case "Wh", "BTU":
+		return prefixRange{femto, giga}
+	case "eV":
+		// 1000MeV is less than 1nJ.
+		return prefixRange{giga, exa}
+	}
+	return nanoPrefixes
+}
+
+// MarshalJSON implements json.Marshaler. The Energy is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (e Energy) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano joules.
+func (e *Energy) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, e.Set, func(v int64) error {
+		*e = Energy(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Energy to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (e Energy) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(e), nano, siFormat{mode: siExact}), 'J'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Energy is encoded as
+// appended by AppendText.
+func (e Energy) MarshalText() ([]byte, error) {
+	return e.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (e *Energy) UnmarshalText(text []byte) error {
+	return e.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Energy is stored as an integer of nano
+// joules.
+func (e Energy) Value() (driver.Value, error) {
+	return int64(e), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano joules or
+// text in a format understood by Set.
+func (e *Energy) Scan(src any) error {
+	return scanValue(src, e.Set, func(v int64) error {
+		*e = Energy(v)
+		return nil
+	})
+}
+
+// J returns the energy as a floating number of Joules.
+func (e Energy) J() float64 {
+	return float64(e) / float64(Joule)
+}
+
+// Wh returns the energy as a floating number of watt hours.
+func (e Energy) Wh() float64 {
+	return float64(e) / float64(WattHour)
+}
+
+// KWh returns the energy as a floating number of kilowatt hours.
+func (e Energy) KWh() float64 {
+	return float64(e) / float64(KiloWattHour)
+}
+
+// BTU returns the energy as a floating number of British thermal units.
+func (e Energy) BTU() float64 {
+	return float64(e) / float64(BTU)
+}
+
+// Cal returns the energy as a floating number of thermochemical calories.
+func (e Energy) Cal() float64 {
+	return float64(e) / float64(Calorie)
+}
+
+// KCal returns the energy as a floating number of thermochemical kilocalories.
+func (e Energy) KCal() float64 {
+	return float64(e) / float64(KiloCalorie)
+}
+
+// EV returns the energy as a floating number of electronvolts.
+func (e Energy) EV() float64 {
+	return float64(e) / 1.602176634e-10
+}
+
 const (
 	// Joule is a unit of work. kg⋅m²⋅s⁻²
 	NanoJoule  Energy = 1
@@ -70,6 +263,12 @@ const (
 	WattSecond   Energy = Joule
 	WattHour     Energy = 3600 * Joule
 	KiloWattHour Energy = 3600 * KiloJoule
+	MegaWattHour Energy = 3600 * MegaJoule
+
+	// Calorie is the thermochemical calorie. The food Calorie is one
+	// KiloCalorie.
+	Calorie     Energy = 4184 * MilliJoule
+	KiloCalorie Energy = 1000 * Calorie
 
 	maxEnergy = 9223372036854775807 * NanoJoule
 	minEnergy = -9223372036854775807 * NanoJoule
@@ -66,6 +66,24 @@ func TestEnergy_Set(t *testing.T) {
 		{"9.223372036854775807GJ", 9223372036854775807 * NanoJoule},
 		{"-9.223372036854775807GJ", -9223372036854775807 * NanoJoule},
 		{"1MJ", 1 * MegaJoule},
+		{"1Wh", 1 * WattHour},
+		{"1mWh", 3600 * MilliJoule},
+		{"3.2kWh", 11520 * KiloJoule},
+		{"1MWh", 1 * MegaWattHour},
+		{"-1kWh", -1 * KiloWattHour},
+		{"2562047Wh", 9223369200000000000 * NanoJoule},
+		{"1BTU", 1 * BTU},
+		{"1kBTU", 1055060 * Joule},
+		{"1cal", 1 * Calorie},
+		{"1kcal", 1 * KiloCalorie},
+		{"2.5kcal", 10460 * Joule},
+		{"1TeV", 160 * NanoJoule},
+		{"3.2GeV", 1 * NanoJoule},
+		{"-3.2GeV", -1 * NanoJoule},
+		{"6.241509074TeV", 1 * MicroJoule},
+		{"1PeV", 160218 * NanoJoule},
+		{"1000fWh", 4 * NanoJoule},
+		{"57567760264000000TeV", 9223372036028576484 * NanoJoule},
 	}
 
 	fails := []struct {
@@ -76,21 +94,37 @@ func TestEnergy_Set(t *testing.T) {
 			"10TJ",
 			"maximum value is 9.223GJ",
 		},
+		{
+			"1eV",
+			"nonzero value rounds to zero; resolution is 1nJ",
+		},
+		{
+			"1GeV",
+			"nonzero value rounds to zero; resolution is 1nJ",
+		},
+		{
+			"3.1GeV",
+			"nonzero value rounds to zero; resolution is 1nJ",
+		},
 		{
 			"10EJ",
-			"unknown unit prefix; valid prefixes for \"J\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"J\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"1PJ",
+			"unknown unit prefix; valid prefixes for \"J\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10ExaJ",
-			"unknown unit prefix; valid prefixes for \"J\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"J\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eJouleE",
-			"unknown unit provided; need J",
+			"unknown unit provided; need J, Wh, BTU, cal or eV",
 		},
 		{
 			"10",
-			"no unit provided; need J",
+			"no unit provided; need J, Wh, BTU, cal or eV",
 		},
 		{
 			"9223372036854775808",
@@ -118,7 +152,7 @@ func TestEnergy_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need J",
+			"unknown unit provided; need J, Wh, BTU, cal or eV",
 		},
 		{
 			"J",
@@ -126,7 +160,7 @@ func TestEnergy_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit J",
+			"does not contain number or unit J, Wh, BTU, cal or eV",
 		},
 		{
 			"++1J",
@@ -144,6 +178,46 @@ func TestEnergy_Set(t *testing.T) {
 			"1.1.1.1J",
 			"contains multiple decimal points",
 		},
+		{
+			"2562048Wh",
+			"maximum value is 2562047Wh",
+		},
+		{
+			"-9000000BTU",
+			"minimum value is -8742035BTU",
+		},
+		{
+			"3000000000cal",
+			"maximum value is 2204438823cal",
+		},
+		{
+			"60000000000000000TeV",
+			"maximum value is 9.223GJ",
+		},
+		{
+			"-60000000000000000TeV",
+			"minimum value is -9.223GJ",
+		},
+		{
+			"10EWh",
+			"unknown unit prefix; valid prefixes for \"Wh\" are f,p,n,u,µ,m,c,d,da,h,k,M or G",
+		},
+		{
+			"1TWh",
+			"unknown unit prefix; valid prefixes for \"Wh\" are f,p,n,u,µ,m,c,d,da,h,k,M or G",
+		},
+		{
+			"1peV",
+			"unknown unit prefix; valid prefixes for \"eV\" are G,T,P or E",
+		},
+		{
+			"1MeV",
+			"unknown unit prefix; valid prefixes for \"eV\" are G,T,P or E",
+		},
+		{
+			"1PJ",
+			"unknown unit prefix; valid prefixes for \"J\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
 	}
 
 	for i, tt := range succeeds {
@@ -164,6 +238,48 @@ func TestEnergy_Set(t *testing.T) {
 	}
 }
 
+func TestEnergy_J(t *testing.T) {
+	if v := Energy(123 * Joule).J(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestEnergy_Wh(t *testing.T) {
+	if v := Energy(123 * WattHour).Wh(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestEnergy_KWh(t *testing.T) {
+	if v := Energy(123 * KiloWattHour).KWh(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestEnergy_BTU(t *testing.T) {
+	if v := Energy(123 * BTU).BTU(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestEnergy_Cal(t *testing.T) {
+	if v := Energy(123 * Calorie).Cal(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestEnergy_KCal(t *testing.T) {
+	if v := Energy(123 * KiloCalorie).KCal(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestEnergy_EV(t *testing.T) {
+	if v := Energy(1602176634 * NanoJoule).EV(); v != 1e19 {
+		t.Fatal(v)
+	}
+}
+
 func TestEnergy_RoundTrip(t *testing.T) {
 	x := 123 * Joule
 	var y Energy
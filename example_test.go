@@ -7,6 +7,7 @@
 package unit_test
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
@@ -15,6 +16,46 @@ import (
 	"github.com/sam-rba/unit"
 )
 
+func ExampleAcceleration() {
+	fmt.Println(unit.StandardGravity)
+	fmt.Println(unit.Gal)
+	fmt.Println(unit.FootPerSecondSquared)
+	// Output:
+	// 9.807m/s²
+	// 10mm/s²
+	// 304.800mm/s²
+}
+
+func ExampleAcceleration_Set() {
+	var a unit.Acceleration
+
+	if err := a.Set("9.81m/s²"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(a)
+
+	if err := a.Set("250mg"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(a)
+
+	if err := a.Set("32ft/s^2"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(a)
+	// Output:
+	// 9.810m/s²
+	// 2.452m/s²
+	// 9.754m/s²
+}
+
+func ExampleAcceleration_flag() {
+	var a unit.Acceleration
+
+	flag.Var(&a, "threshold", "acceleration above which a fall is detected")
+	flag.Parse()
+}
+
 func ExampleAngle() {
 	fmt.Println(unit.Degree)
 	fmt.Println(unit.Pi)
@@ -74,6 +115,113 @@ func ExampleAngle_float64() {
 	// 0.785398rad
 }
 
+func ExampleAngularVelocity() {
+	fmt.Println(unit.RadianPerSecond)
+	fmt.Println(unit.DegreePerSecond)
+	fmt.Println(unit.RevolutionPerMinute)
+	// Output:
+	// 1rad/s
+	// 17.453mrad/s
+	// 104.720mrad/s
+}
+
+func ExampleAngularVelocity_Set() {
+	var w unit.AngularVelocity
+
+	if err := w.Set("250dps"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(w)
+	fmt.Printf("%.1f°/s\n", w.DPS())
+
+	if err := w.Set("3000rpm"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(w)
+	fmt.Println(w.Frequency())
+	// Output:
+	// 4.363rad/s
+	// 250.0°/s
+	// 314.159rad/s
+	// 50Hz
+}
+
+func ExampleAngularVelocity_Mul() {
+	// A gyroscope reading integrated over one sample period.
+	w := 45 * unit.DegreePerSecond
+	fmt.Println(w.Mul(2 * time.Second))
+	fmt.Println((90 * unit.Degree).Div(time.Second))
+	// Output:
+	// 90.00°
+	// 1.571rad/s
+}
+
+func ExampleAngularVelocity_flag() {
+	var w unit.AngularVelocity
+
+	flag.Var(&w, "rate", "maximum rotation rate of the turntable")
+	flag.Parse()
+}
+
+func ExampleArea() {
+	fmt.Println(unit.SquareMetre)
+	fmt.Println(unit.Hectare)
+	fmt.Println(unit.Acre)
+	fmt.Println(unit.SquareMile)
+	// Output:
+	// 1m²
+	// 10000m²
+	// 4046.856m²
+	// 2.590km²
+}
+
+func ExampleArea_Set() {
+	var a unit.Area
+
+	if err := a.Set("12.5ha"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(a)
+
+	if err := a.Set("850ft²"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(a)
+
+	if err := a.Set("2.5cm2"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(a)
+	// Output:
+	// 125000m²
+	// 78.968m²
+	// 250mm²
+}
+
+func ExampleArea_Mul() {
+	floor := (4 * unit.Metre).Mul(3 * unit.Metre)
+	fmt.Println(floor)
+	fmt.Println(floor.Mul(100 * unit.MilliMetre))
+
+	// A 70kg person standing on 400cm² of soles.
+	var soles unit.Area
+	if err := soles.Set("400cm²"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(unit.Weight(70*unit.KiloGram, unit.StandardGravity).Div(soles))
+	// Output:
+	// 12m²
+	// 1.200kL
+	// 17.162kPa
+}
+
+func ExampleArea_flag() {
+	var a unit.Area
+
+	flag.Var(&a, "area", "surface of the field to irrigate")
+	flag.Parse()
+}
+
 func ExampleDistance() {
 	fmt.Println(unit.Inch)
 	fmt.Println(unit.Foot)
@@ -108,6 +256,44 @@ func ExampleDistance_Set() {
 
 }
 
+func ExampleDistance_Format() {
+	d := 1234500 * unit.MicroMetre
+
+	fmt.Printf("%v\n", d)
+	fmt.Printf("%.6v\n", d)
+	fmt.Printf("[%10v]\n", d)
+	fmt.Printf("%+v\n", d)
+	// Output:
+	// 1.234m
+	// 1.234500m
+	// [    1.234m]
+	// 1.234m (1234500000nm)
+}
+
+func ExampleDistance_FormatUnit() {
+	d := 1234500 * unit.MicroMetre
+
+	s, err := d.FormatUnit("mm", 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(s)
+	// Output:
+	// 1234.5mm
+}
+
+func ExampleDistance_FeetInches() {
+	var d unit.Distance
+	if err := d.Set("1.75m"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(d.FeetInches(16))
+	fmt.Println(d.FeetInches(32))
+	// Output:
+	// 5'8 7/8"
+	// 5'8 29/32"
+}
+
 func ExampleDistance_flag() {
 	var d unit.Distance
 
@@ -115,6 +301,25 @@ func ExampleDistance_flag() {
 	flag.Parse()
 }
 
+func ExampleDistance_json() {
+	var v struct {
+		Travel unit.Distance
+		Offset unit.Distance
+	}
+
+	// Both the formatted string and the raw nano metre count are accepted.
+	if err := json.Unmarshal([]byte(`{"Travel":"1.5m","Offset":2500000}`), &v); err != nil {
+		log.Fatal(err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(b))
+	// Output:
+	// {"Travel":"1.5m","Offset":"2.5mm"}
+}
+
 func ExampleDistance_float64() {
 	// Distance between the Earth and the Moon.
 	v := 384400 * unit.KiloMetre
@@ -180,6 +385,105 @@ func ExampleElectricalCapacitance_float64() {
 	// 4.7µF
 }
 
+func ExampleElectricalConductance() {
+	fmt.Println(250 * unit.MicroSiemens)
+	fmt.Println(2 * unit.Siemens)
+	// Output:
+	// 250µS
+	// 2S
+}
+
+func ExampleElectricalConductance_Set() {
+	var g unit.ElectricalConductance
+
+	if err := g.Set("1.5mS"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(g)
+
+	if err := g.Set("50µmho"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(g)
+	// Output:
+	// 1.500mS
+	// 50µS
+}
+
+func ExampleElectricalConductance_Resistance() {
+	fmt.Println((2 * unit.MilliSiemens).Resistance())
+	fmt.Println((10 * unit.KiloOhm).Conductance())
+	// Output:
+	// 500Ω
+	// 100µS
+}
+
+func ExampleElectricalConductance_flag() {
+	var g unit.ElectricalConductance
+
+	flag.Var(&g, "gm", "transconductance of the amplifier")
+	flag.Parse()
+}
+
+func ExampleElectricCharge() {
+	fmt.Println(3000 * unit.MilliAmpereHour)
+	fmt.Println(10 * unit.Coulomb)
+	fmt.Println(-10 * unit.MicroCoulomb)
+	// Output:
+	// 10.800kC
+	// 10C
+	// -10µC
+}
+
+func ExampleElectricCharge_Set() {
+	var q unit.ElectricCharge
+
+	if err := q.Set("3000mAh"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(q)
+
+	if err := q.Set("2.5Ah"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(q)
+
+	if err := q.Set("12mC"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(q)
+	// Output:
+	// 10.800kC
+	// 9kC
+	// 12mC
+}
+
+func ExampleElectricCharge_Mul() {
+	// The energy stored in a 3000mAh lithium-ion cell at its nominal voltage.
+	var q unit.ElectricCharge
+	if err := q.Set("3000mAh"); err != nil {
+		log.Fatal(err)
+	}
+	e := q.Mul(3700 * unit.MilliVolt)
+
+	s, err := e.FormatUnit("Wh", 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(s)
+	fmt.Println(e)
+	// Output:
+	// 11.1Wh
+	// 39.960kJ
+}
+
+func ExampleElectricCharge_flag() {
+	var q unit.ElectricCharge
+
+	flag.Var(&q, "capacity", "rated battery capacity")
+	flag.Parse()
+}
+
 func ExampleElectricCurrent() {
 	fmt.Println(10010 * unit.MilliAmpere)
 	fmt.Println(10 * unit.Ampere)
@@ -234,6 +538,16 @@ func ExampleElectricCurrent_float64() {
 	// 0.051A
 }
 
+func ExampleElectricCurrent_Mul() {
+	// Charge delivered by a 500mA charger in two hours.
+	q := (500 * unit.MilliAmpere).Mul(2 * time.Hour)
+	fmt.Println(q)
+	fmt.Printf("%.0fmAh\n", q.MAh())
+	// Output:
+	// 3.600kC
+	// 1000mAh
+}
+
 func ExampleElectricPotential() {
 	fmt.Println(10010 * unit.MilliVolt)
 	fmt.Println(10 * unit.Volt)
@@ -363,9 +677,17 @@ func ExampleEnergy_Set() {
 	}
 	fmt.Println(e)
 
+	if err := e.Set("3.2kWh"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(e)
+	fmt.Println(e.KWh())
+
 	// Output:
 	// 2.600kJ
 	// 45mJ
+	// 11.520MJ
+	// 3.2
 }
 
 func ExampleEnergy_flag() {
@@ -419,6 +741,17 @@ func ExampleForce_Set() {
 	// 88.964N
 }
 
+func ExampleWeight() {
+	m := 20 * unit.KiloGram
+
+	// Weight under standard gravity and at 60° north.
+	fmt.Println(unit.Weight(m, unit.StandardGravity))
+	fmt.Println(unit.Weight(m, unit.EarthGravityAt(60*unit.Degree)))
+	// Output:
+	// 196.133N
+	// 196.384N
+}
+
 func ExampleForce_flag() {
 	var f unit.Force
 
@@ -517,6 +850,45 @@ func ExamplePeriodToFrequency() {
 	// 16.667mHz
 }
 
+func ExampleInductance() {
+	fmt.Println(470 * unit.MicroHenry)
+	fmt.Println(10 * unit.MilliHenry)
+	// Output:
+	// 470µH
+	// 10mH
+}
+
+func ExampleInductance_Set() {
+	var l unit.Inductance
+
+	if err := l.Set("4.7µH"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(l)
+
+	if err := l.Set("22mH"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(l)
+	// Output:
+	// 4.700µH
+	// 22mH
+}
+
+func ExampleInductance_Mul() {
+	// Flux linked by a motor winding carrying its rated current.
+	fmt.Println((12 * unit.MilliHenry).Mul(5 * unit.Ampere))
+	// Output:
+	// 60mWb
+}
+
+func ExampleInductance_flag() {
+	var l unit.Inductance
+
+	flag.Var(&l, "choke", "inductance of the output filter choke")
+	flag.Parse()
+}
+
 func ExampleLuminousFlux() {
 	fmt.Println(18282 * unit.Lumen)
 	// Output:
@@ -602,6 +974,69 @@ func ExampleLuminousIntensity_float64() {
 	// 35.8
 }
 
+func ExampleMagneticFlux() {
+	fmt.Println(2 * unit.MilliWeber)
+	fmt.Println(unit.Maxwell)
+	// Output:
+	// 2mWb
+	// 10nWb
+}
+
+func ExampleMagneticFlux_Set() {
+	var m unit.MagneticFlux
+
+	if err := m.Set("1.2mWb"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(m)
+
+	if err := m.Set("500kMx"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(m)
+	// Output:
+	// 1.200mWb
+	// 5mWb
+}
+
+func ExampleMagneticFlux_flag() {
+	var m unit.MagneticFlux
+
+	flag.Var(&m, "flux", "magnetic flux per pole")
+	flag.Parse()
+}
+
+func ExampleMagneticFluxDensity() {
+	fmt.Println(45 * unit.MicroTesla)
+	// Output:
+	// 45µT
+}
+
+func ExampleMagneticFluxDensity_Set() {
+	var b unit.MagneticFluxDensity
+
+	if err := b.Set("0.5mT"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(b)
+
+	if err := b.Set("450mG"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(b)
+
+	// Output:
+	// 500µT
+	// 45µT
+}
+
+func ExampleMagneticFluxDensity_Mul() {
+	// Flux through the 4cm² cross-section of a transformer core at 1.2T.
+	fmt.Println((1200 * unit.MilliTesla).Mul(4 * unit.SquareCentimetre))
+	// Output:
+	// 480µWb
+}
+
 func ExampleMass() {
 	fmt.Println(10 * unit.MilliGram)
 	fmt.Println(unit.OunceMass)
@@ -664,6 +1099,15 @@ func ExampleMass_float64() {
 	// 6.27g
 }
 
+func ExampleMass_Mul() {
+	m := 1500 * unit.KiloGram
+	fmt.Println(m.Mul(3 * unit.MetrePerSecondSquared))
+	fmt.Println(unit.KiloGram.Mul(unit.StandardGravity) == unit.EarthGravity)
+	// Output:
+	// 4.500kN
+	// true
+}
+
 func ExamplePower() {
 	fmt.Println(1 * unit.Watt)
 	fmt.Println(16 * unit.MilliWatt)
@@ -739,9 +1183,21 @@ func ExamplePressure_Set() {
 		log.Fatal(err)
 	}
 	fmt.Println(p)
+
+	if err := p.Set("32psi"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(p)
+
+	if err := p.Set("1013.25mbar"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(p)
 	// Output:
 	// 300kPa
 	// 16MPa
+	// 220.632kPa
+	// 101.325kPa
 }
 
 func ExamplePressure_flag() {
@@ -854,6 +1310,35 @@ func ExampleSpeed_Set() {
 	// 24.587m/s
 }
 
+func ExampleSpeed_Div() {
+	// From 0 to 100km/h in 2.7s.
+	a := (100 * unit.KilometrePerHour).Div(2700 * time.Millisecond)
+	fmt.Println(a)
+	fmt.Printf("%.2fg\n", a.G())
+	// Output:
+	// 10.288m/s²
+	// 1.05g
+}
+
+func ExampleSpeed_Pace() {
+	var sp unit.Speed
+	if err := sp.Set("4:30/km"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(sp)
+	fmt.Println(sp.Pace(unit.Mile).Round(time.Second))
+
+	s, err := sp.FormatUnit("/mi", 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(s)
+	// Output:
+	// 3.704m/s
+	// 7m15s
+	// 7:15/mi
+}
+
 func ExampleSpeed_flag() {
 	var s unit.Speed
 
@@ -895,7 +1380,7 @@ func ExampleTemperature() {
 	// 26.667°C
 }
 
-func ExampleTemperature_Celsius() {
+func ExampleTemperature_C() {
 	// Normal average human body temperature.
 	v := 37*unit.Celsius + unit.ZeroCelsius
 
@@ -909,7 +1394,7 @@ func ExampleTemperature_Celsius() {
 	// 37.0°C
 }
 
-func ExampleTemperature_Fahrenheit() {
+func ExampleTemperature_F() {
 	// Normal average human body temperature.
 	v := 37*unit.Celsius + unit.ZeroCelsius
 
@@ -923,6 +1408,24 @@ func ExampleTemperature_Fahrenheit() {
 	// 98.6°F
 }
 
+func ExampleTemperature_FormatUnit() {
+	// Normal average human body temperature.
+	v := 37*unit.Celsius + unit.ZeroCelsius
+
+	for _, symbol := range []string{"°C", "°F", "K", "°R"} {
+		s, err := v.FormatUnit(symbol, 2)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(s)
+	}
+	// Output:
+	// 37.00°C
+	// 98.60°F
+	// 310.15K
+	// 558.27°R
+}
+
 func ExampleTemperature_Set() {
 	var t unit.Temperature
 
@@ -959,6 +1462,32 @@ func ExampleTemperature_Set() {
 	// 0°C
 }
 
+func ExampleTemperature_Sub() {
+	morning := 18*unit.Celsius + unit.ZeroCelsius
+	noon := 21*unit.Celsius + unit.ZeroCelsius
+
+	// The room warmed by 3°C.
+	d := noon.Sub(morning)
+	fmt.Println(d)
+	fmt.Printf("%.1f°F\n", d.F())
+	// Output:
+	// 3°C
+	// 5.4°F
+}
+
+func ExampleTemperature_Add() {
+	var offset unit.TemperatureDifference
+	if err := offset.Set("-0.9°F"); err != nil {
+		log.Fatal(err)
+	}
+
+	// Apply a calibration offset to a sensor reading.
+	reading := 22*unit.Celsius + unit.ZeroCelsius
+	fmt.Println(reading.Add(offset))
+	// Output:
+	// 21.500°C
+}
+
 func ExampleTemperature_flag() {
 	var t unit.Temperature
 
@@ -7,7 +7,10 @@
 package unit
 
 import (
+	"database/sql/driver"
 	"errors"
+	"fmt"
+	"math"
 	"unicode/utf8"
 )
 
@@ -23,22 +26,47 @@ type Force int64
 
 // String returns the force formatted as a string in Newton.
 func (f Force) String() string {
-	return nanoAsString(int64(f)) + "N"
+	return formatSI(int64(f), nano, siFormat{}) + "N"
 }
 
-// Set sets the Force to the value represented by s. Units are to
-// be provided in "N", or "lbf" (Pound force) with an optional SI prefix: "p",
-// "n", "u", "µ", "m", "k", "M", "G" or "T".
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (f Force) Format(s fmt.State, verb rune) {
+	formatQuantity(s, verb, int64(f), "nN", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(f), nano, siPrecision(prec)), 'N')
+	})
+}
+
+// FormatUnit returns the force formatted in one of the units accepted by Set,
+// "N", "lbf", "gf", "dyn", "kip" or "ozf", with an optional SI prefix, rounded
+// to precision digits after the decimal point. A negative precision uses as
+// many digits as necessary to represent the force exactly.
+func (f Force) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(f), symbol, precision,
+		// Same conversion factor as Set.
+		scaledUnit{"lbf", 4448221615261, 1000},
+		scaledUnit{"gf", int64(GramForce), 1},
+		scaledUnit{"dyn", int64(Dyne), 1},
+		scaledUnit{"kip", 44482216152605, 10},
+		scaledUnit{"ozf", 27801385095378125, 100000000},
+		scaledUnit{"N", int64(Newton), 1},
+	)
+}
+
+// Set sets the Force to the value represented by s. Units are to be provided in
+// "N", "lbf" (pound force), "gf" (gram force), "dyn" (dyne), "kip" or "ozf"
+// (ounce force) with an optional SI prefix: "p", "n", "u", "µ", "m", "c", "d",
+// "da", "h", "k", "M", "G" or "T". Kilogram force is "kgf".
 func (f *Force) Set(s string) error {
 	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s[n:], "N", "lbf"); found != "" {
+				if found := hasSuffixes(s[n:], forceUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("N or lbf")
+				return notNumberUnitErr("N, lbf, gf, dyn, kip or ozf")
 			case errOverflowsInt64:
 				// TODO(maruel): Look for suffix, and reuse it.
 				return maxValueErr(maxForce.String())
@@ -56,31 +84,22 @@ func (f *Force) Set(s string) error {
 		if r <= 1 || rsize == 0 {
 			return errors.New("unexpected end of string")
 		}
-		var siSize int
-		si, siSize = parseSIPrefix(r)
-
-		n += siSize
+		switch s[n:] {
+		case "dyn", "kip":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
 	}
 
+	// factor is the value of the unit in nano Newtons and maxUnit is the
+	// largest representable value, formatted in the unit when it is short
+	// enough.
+	var factor decimal
+	var maxUnit string
 	switch s[n:] {
-	case "lbf":
-		poundForce := decimal{
-			base: 4448221615261,
-			exp:  -3,
-			neg:  false,
-		}
-		lbf, loss := decimalMul(d, poundForce)
-		if loss > 9 {
-			return errors.New("converting to nano Newtons would overflow, consider using nN for maximum precision")
-		}
-		v, overflow := dtoi(lbf, int(si))
-		if overflow {
-			if lbf.neg {
-				return minValueErr("-2.073496519Glbf")
-			}
-			return maxValueErr("2.073496519Glbf")
-		}
-		*f = (Force)(v)
 	case "N":
 		v, overflow := dtoi(d, int(si-nano))
 		if overflow {
@@ -89,18 +108,134 @@ func (f *Force) Set(s string) error {
 			}
 			return maxValueErr(maxForce.String())
 		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoNewton.String())
+		}
 		*f = (Force)(v)
+		return nil
+	case "lbf":
+		factor, maxUnit = decimal{base: 4448221615261, exp: -3}, "2.073496519Glbf"
+	case "gf":
+		factor, maxUnit = decimal{base: 980665, exp: 1}, "940522200kgf"
+	case "dyn":
+		factor, maxUnit = decimal{base: 1, exp: 4}, "922337203685477dyn"
+	case "kip":
+		// 1kip is exactly 1000lbf.
+		factor, maxUnit = decimal{base: 44482216152605, exp: -1}, "2073496kip"
+	case "ozf":
+		// 1ozf is exactly 1/16lbf.
+		factor, maxUnit = decimal{base: 27801385095378125, exp: -8}, "33175944310ozf"
 	case "":
-		return noUnitErr("N or lbf")
+		return noUnitErr("N, lbf, gf, dyn, kip or ozf")
 	default:
-		if found := hasSuffixes(s[n:], "N", "lbf"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], forceUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
-		return incorrectUnitErr("N or lbf")
+		return incorrectUnitErr("N, lbf, gf, dyn, kip or ozf")
+	}
+	v, lossy, overflow := dtoiScaled(d, factor, si)
+	if lossy {
+		return errors.New("converting to nano Newtons would overflow, consider using nN for maximum precision")
 	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + maxUnit)
+		}
+		return maxValueErr(maxUnit)
+	}
+	if roundsToZero(d, v) {
+		return resolutionErr(NanoNewton.String())
+	}
+	*f = (Force)(v)
 	return nil
 }
 
+// forceUnits are the units accepted by Force.Set.
+var forceUnits = []string{"N", "lbf", "gf", "dyn", "kip", "ozf"}
+
+// MarshalJSON implements json.Marshaler. The Force is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (f Force) MarshalJSON() ([]byte, error) {
+	return marshalJSON(f.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano newtons.
+func (f *Force) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, f.Set, func(v int64) error {
+		*f = Force(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Force to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (f Force) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(f), nano, siFormat{mode: siExact}), 'N'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Force is encoded as
+// appended by AppendText.
+func (f Force) MarshalText() ([]byte, error) {
+	return f.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (f *Force) UnmarshalText(text []byte) error {
+	return f.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Force is stored as an integer of nano
+// newtons.
+func (f Force) Value() (driver.Value, error) {
+	return int64(f), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano newtons or
+// text in a format understood by Set.
+func (f *Force) Scan(src any) error {
+	return scanValue(src, f.Set, func(v int64) error {
+		*f = Force(v)
+		return nil
+	})
+}
+
+// Weight returns the force exerted on the mass m by the gravitational
+// acceleration g, StandardGravity or the result of EarthGravityAt for a given
+// latitude. The result saturates at the highest representable Force.
+func Weight(m Mass, g Acceleration) Force {
+	neg := g < 0
+	if neg {
+		g = -g
+	}
+	// One nano gram times one nano metre per second squared is a trillionth
+	// of a nano newton.
+	v, overflow := mulDiv(int64(m), int64(g), int64(KiloGram))
+	switch {
+	case overflow && (m < 0) != neg:
+		return minForce
+	case overflow:
+		return maxForce
+	case neg:
+		return Force(-v)
+	}
+	return Force(v)
+}
+
+// EarthGravityAt returns the acceleration due to gravity at sea level at the
+// geodetic latitude, like StandardGravity is for the nominal value.
+//
+// It uses the WGS 84 ellipsoidal gravity formula, which ranges from
+// 9.7803m/s² at the equator to 9.8322m/s² at the poles.
+func EarthGravityAt(latitude Angle) Acceleration {
+	sin := math.Sin(float64(latitude) / float64(Radian))
+	sin2 := sin * sin
+	g := 9.7803253359 * (1 + 0.00193185265241*sin2) / math.Sqrt(1-0.00669437999013*sin2)
+	return Acceleration(math.Round(g * float64(MetrePerSecondSquared)))
+}
+
 const (
 	// Newton is kg⋅m/s².
 	NanoNewton  Force = 1
@@ -111,12 +246,22 @@ const (
 	MegaNewton  Force = 1000 * KiloNewton
 	GigaNewton  Force = 1000 * MegaNewton
 
+	// EarthGravity is the weight of one kilogram under StandardGravity.
 	EarthGravity Force = 9806650 * MicroNewton
 
 	// Conversion between Newton and imperial units.
 	// Pound is both a unit of mass and weight (force). The suffix Force is added
 	// to disambiguate the measurement it represents.
 	PoundForce Force = 4448221615 * NanoNewton
+	OunceForce Force = 278013851 * NanoNewton
+	Kip        Force = 4448221615261 * NanoNewton
+
+	// Gravitational metric units, the weight of a mass under standard gravity.
+	GramForce     Force = 9806650 * NanoNewton
+	KiloGramForce Force = 1000 * GramForce
+
+	// Dyne is the CGS unit of force, g⋅cm/s².
+	Dyne Force = 10 * MicroNewton
 
 	maxForce Force = (1 << 63) - 1
 	minForce Force = -((1 << 63) - 1)
@@ -56,6 +56,20 @@ func TestForce_Set(t *testing.T) {
 		{"2Mlbf", 8896443230522000 * NanoNewton},
 		{"2073496519lbf", 9223372034443058185 * NanoNewton},
 		{"1.0000000000101lbf", 4448221615 * NanoNewton},
+		{"1kgf", 1 * KiloGramForce},
+		{"1kgf", 1 * EarthGravity},
+		{"1gf", 1 * GramForce},
+		{"2.5mgf", 24517 * NanoNewton},
+		{"940522200kgf", 9223372032630000000 * NanoNewton},
+		{"1dyn", 1 * Dyne},
+		{"100000dyn", 1 * Newton},
+		{"-3.5dyn", -35 * MicroNewton},
+		{"1kip", 1 * Kip},
+		{"1kip", 1000*PoundForce + 261*NanoNewton},
+		{"2073496kip", 9223369726357222456 * NanoNewton},
+		{"1ozf", 1 * OunceForce},
+		{"16ozf", 4448221615 * NanoNewton},
+		{"1kozf", 278013850954 * NanoNewton},
 	}
 
 	fails := []struct {
@@ -74,29 +88,53 @@ func TestForce_Set(t *testing.T) {
 			"1234567.890123456789lbf",
 			"converting to nano Newtons would overflow, consider using nN for maximum precision",
 		},
+		{
+			"940522201kgf",
+			"maximum value is 940522200kgf",
+		},
+		{
+			"-1Tgf",
+			"minimum value is -940522200kgf",
+		},
+		{
+			"922337203685478dyn",
+			"maximum value is 922337203685477dyn",
+		},
+		{
+			"2073497kip",
+			"maximum value is 2073496kip",
+		},
+		{
+			"-33175944311ozf",
+			"minimum value is -33175944310ozf",
+		},
+		{
+			"10Eozf",
+			"unknown unit prefix; valid prefixes for \"ozf\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
 		{
 			"10TN",
 			"maximum value is 9.223GN",
 		},
 		{
 			"10EN",
-			"unknown unit prefix; valid prefixes for \"N\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"N\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10ExaN",
-			"unknown unit prefix; valid prefixes for \"N\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"N\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eNewtonE",
-			"unknown unit provided; need N or lbf",
+			"unknown unit provided; need N, lbf, gf, dyn, kip or ozf",
 		},
 		{
 			"10",
-			"no unit provided; need N or lbf",
+			"no unit provided; need N, lbf, gf, dyn, kip or ozf",
 		},
 		{
 			"10n",
-			"no unit provided; need N or lbf",
+			"no unit provided; need N, lbf, gf, dyn, kip or ozf",
 		},
 		{
 			"9223372036854775808",
@@ -132,7 +170,7 @@ func TestForce_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need N or lbf",
+			"unknown unit provided; need N, lbf, gf, dyn, kip or ozf",
 		},
 		{
 			"N",
@@ -140,7 +178,7 @@ func TestForce_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit N or lbf",
+			"does not contain number or unit N, lbf, gf, dyn, kip or ozf",
 		},
 		{
 			"++1N",
@@ -192,3 +230,43 @@ func TestForce_RoundTrip(t *testing.T) {
 		t.Fatalf("Force expected %s to equal %s", x, y)
 	}
 }
+
+func TestWeight(t *testing.T) {
+	data := []struct {
+		m        Mass
+		g        Acceleration
+		expected Force
+	}{
+		{KiloGram, StandardGravity, KiloGramForce},
+		{Gram, StandardGravity, GramForce},
+		{-2 * KiloGram, StandardGravity, -2 * KiloGramForce},
+		{PoundMass, StandardGravity, PoundForce},
+		{500 * Gram, EarthGravityAt(0), 4890162668 * NanoNewton},
+		{KiloGram, -StandardGravity, -EarthGravity},
+		{maxMass, maxAcceleration, maxForce},
+		{-maxMass, maxAcceleration, minForce},
+		{maxMass, -maxAcceleration, minForce},
+	}
+	for i, line := range data {
+		if v := Weight(line.m, line.g); v != line.expected {
+			t.Fatalf("%d: Weight(%s, %s) = %s(%d) != %s(%d)", i, line.m, line.g, v, v, line.expected, line.expected)
+		}
+	}
+}
+
+func TestEarthGravityAt(t *testing.T) {
+	data := []struct {
+		in       Angle
+		expected Acceleration
+	}{
+		{0, 9780325336 * NanoMetrePerSecondSquared},
+		{45 * Degree, 9806197770 * NanoMetrePerSecondSquared},
+		{-45 * Degree, 9806197770 * NanoMetrePerSecondSquared},
+		{90 * Degree, 9832184938 * NanoMetrePerSecondSquared},
+	}
+	for i, line := range data {
+		if v := EarthGravityAt(line.in); v != line.expected {
+			t.Fatalf("%d: EarthGravityAt(%s) = %s(%d) != %s(%d)", i, line.in, v, v, line.expected, line.expected)
+		}
+	}
+}
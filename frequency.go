@@ -6,7 +6,14 @@
 
 package unit
 
-import "time"
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+)
 
 // Frequency is a measurement of cycle per second, stored as an int64 micro
 // Hertz.
@@ -16,24 +23,54 @@ type Frequency int64
 
 // String returns the frequency formatted as a string in Hertz.
 func (f Frequency) String() string {
-	return microAsString(int64(f)) + "Hz"
+	return formatSI(int64(f), micro, siFormat{}) + "Hz"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (f Frequency) Format(s fmt.State, verb rune) {
+	formatQuantity(s, verb, int64(f), "µHz", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(f), micro, siPrecision(prec)), "Hz"...)
+	})
 }
 
-// Set sets the Frequency to the value represented by s. Units are to
-// be provided in "Hz" or "rpm" with an optional SI prefix: "p", "n", "u", "µ",
-// "m", "k", "M", "G" or "T".
+// FormatUnit returns the frequency formatted in one of the units accepted by
+// Set, "Hz", "rps", "rpm", "bpm" or "cpm", with an optional SI prefix, rounded
+// to precision digits after the decimal point. A negative precision uses as
+// many digits as necessary to represent the frequency exactly.
+func (f Frequency) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(f), symbol, precision,
+		scaledUnit{"Hz", int64(Hertz), 1},
+		scaledUnit{"rps", int64(Hertz), 1},
+		// One per minute is exactly 1/60Hz.
+		scaledUnit{"rpm", int64(Hertz), 60},
+		scaledUnit{"RPM", int64(Hertz), 60},
+		scaledUnit{"bpm", int64(Hertz), 60},
+		scaledUnit{"cpm", int64(Hertz), 60},
+	)
+}
+
+// Set sets the Frequency to the value represented by s. Units are to be
+// provided in "Hz", "rps" (revolutions per second), "rpm" (revolutions per
+// minute), "bpm" (beats per minute) or "cpm" (cycles per minute) with an
+// optional SI prefix. "Hz" and "rps" accept "n", "u", "µ", "m", "c", "d", "da",
+// "h", "k", "M", "G", "T" or "P" and the per minute units accept "u" to "P".
+//
+// The outermost prefixes only reach part of their range: the resolution is
+// 1µHz and the highest representable value is 9.2THz, so "500nHz" is 1µHz but
+// "1nHz" rounds to zero, and "0.009PHz" is 9THz but "1PHz" overflows.
 //
 // Unlike most Set() functions, "Hz" is assumed by default.
 func (f *Frequency) Set(s string) error {
-	v, n, err := valueOfUnitString(s, micro)
+	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s, "Hz", "hz"); found != "" {
+				if found := hasSuffixes(s[n:], frequencyUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("Hz")
+				return notNumberUnitErr("Hz, rps, rpm, bpm or cpm")
 			case errOverflowsInt64:
 				return maxValueErr(maxFrequency.String())
 			case errOverflowsInt64Negative:
@@ -43,18 +80,138 @@ func (f *Frequency) Set(s string) error {
 		return err
 	}
 
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "Hz", "hz", "rps", "rpm", "RPM", "bpm", "cpm":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], microPrefixes)
+			n += siSize
+		}
+	}
+	if r := frequencyPrefixes(s[n:]); si != unit && !r.contains(si) && slices.Contains(frequencyUnits, s[n:]) {
+		return unknownUnitPrefixErr(s[n:], r)
+	}
+
+	v, overflow := dtoi(d, int(si-micro))
 	switch s[n:] {
-	case "Hz", "hz", "":
+	case "Hz", "hz", "rps", "":
+		if overflow {
+			if d.neg {
+				return minValueErr(minFrequency.String())
+			}
+			return maxValueErr(maxFrequency.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(MicroHertz.String())
+		}
+		*f = (Frequency)(v)
+	case "rpm", "RPM", "bpm", "cpm":
+		if overflow {
+			if d.neg {
+				return minValueErr("-9.223T" + s[n:])
+			}
+			return maxValueErr("9.223T" + s[n:])
+		}
+		// v is in micro cycles per minute.
+		if v >= 0 {
+			v = (v + 30) / 60
+		} else {
+			v = (v - 30) / 60
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(MicroHertz.String())
+		}
 		*f = (Frequency)(v)
 	default:
-		if found := hasSuffixes(s[n:], "Hz", "hz"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if overflow {
+			if d.neg {
+				return minValueErr(minFrequency.String())
+			}
+			return maxValueErr(maxFrequency.String())
 		}
-		return incorrectUnitErr("Hz")
+		if found := hasSuffixes(s[n:], frequencyUnits...); found != "" {
+			return unknownUnitPrefixErr(found, frequencyPrefixes(found))
+		}
+		return incorrectUnitErr("Hz, rps, rpm, bpm or cpm")
 	}
 	return nil
 }
 
+// frequencyUnits are the units accepted by Frequency.Set.
+var frequencyUnits = []string{"Hz", "hz", "rps", "rpm", "RPM", "bpm", "cpm"}
+
+// frequencyPrefixes returns the SI prefixes accepted by Frequency.Set before
+// unit, those with which a value between 0.001 and 1000 of the prefixed unit is
+// representable.
+func frequencyPrefixes(unit string) prefixRange {
+	switch unit {
+	case "rpm", "RPM", "bpm", "cpm":
+		return prefixRange{micro, peta}
+	}
+	return microPrefixes
+}
+
+// MarshalJSON implements json.Marshaler. The Frequency is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (f Frequency) MarshalJSON() ([]byte, error) {
+	return marshalJSON(f.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of micro hertz.
+func (f *Frequency) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, f.Set, func(v int64) error {
+		*f = Frequency(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Frequency to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (f Frequency) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(f), micro, siFormat{mode: siExact}), "Hz"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Frequency is encoded as
+// appended by AppendText.
+func (f Frequency) MarshalText() ([]byte, error) {
+	return f.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (f *Frequency) UnmarshalText(text []byte) error {
+	return f.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Frequency is stored as an integer of
+// micro hertz.
+func (f Frequency) Value() (driver.Value, error) {
+	return int64(f), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of micro hertz or
+// text in a format understood by Set.
+func (f *Frequency) Scan(src any) error {
+	return scanValue(src, f.Set, func(v int64) error {
+		*f = Frequency(v)
+		return nil
+	})
+}
+
+// RPM returns the frequency as a floating number of revolutions per minute.
+func (f Frequency) RPM() float64 {
+	return float64(f) * 60 / float64(Hertz)
+}
+
 // Period returns the duration of one cycle at this frequency.
 //
 // Frequency above GigaHertz cannot be represented as Duration.
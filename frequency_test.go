@@ -121,6 +121,9 @@ func TestFrequency_Set(t *testing.T) {
 		{"10mHz", 10 * MilliHertz},
 		{"100mHz", 100 * MilliHertz},
 		{"1hz", 1 * Hertz},
+		{"1hHz", 100 * Hertz},
+		{"0.001PHz", 1 * TeraHertz},
+		{"1000nHz", 1 * MicroHertz},
 		{"1Hz", 1 * Hertz},
 		{"10", 10 * Hertz},
 		{"10Hz", 10 * Hertz},
@@ -141,6 +144,16 @@ func TestFrequency_Set(t *testing.T) {
 		{"-12.345Hz", -12345 * MilliHertz},
 		{"9.223372036854775807THz", 9223372036854775807 * MicroHertz},
 		{"-9.223372036854775807THz", -9223372036854775807 * MicroHertz},
+		{"1rps", 1 * Hertz},
+		{"2.5krps", 2500 * Hertz},
+		{"60rpm", 1 * Hertz},
+		{"60RPM", 1 * Hertz},
+		{"1rpm", 1 * RPM},
+		{"-1rpm", -1 * RPM},
+		{"3krpm", 50 * Hertz},
+		{"120bpm", 2 * Hertz},
+		{"90cpm", 1500 * MilliHertz},
+		{"1mrpm", 17 * MicroHertz},
 	}
 
 	fails := []struct {
@@ -153,15 +166,15 @@ func TestFrequency_Set(t *testing.T) {
 		},
 		{
 			"10EHz",
-			"unknown unit prefix; valid prefixes for \"Hz\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"Hz\" are n,u,µ,m,c,d,da,h,k,M,G,T or P",
 		},
 		{
 			"10ExaHz",
-			"unknown unit prefix; valid prefixes for \"Hz\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"Hz\" are n,u,µ,m,c,d,da,h,k,M,G,T or P",
 		},
 		{
 			"10eHzE",
-			"unknown unit provided; need Hz",
+			"unknown unit provided; need Hz, rps, rpm, bpm or cpm",
 		},
 		{
 			"922337203685477580",
@@ -189,7 +202,7 @@ func TestFrequency_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need Hz",
+			"unknown unit provided; need Hz, rps, rpm, bpm or cpm",
 		},
 		{
 			"Hz",
@@ -197,7 +210,27 @@ func TestFrequency_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit Hz",
+			"not a number",
+		},
+		{
+			"Hertz",
+			"does not contain number or unit Hz, rps, rpm, bpm or cpm",
+		},
+		{
+			"10Erpm",
+			"unknown unit prefix; valid prefixes for \"rpm\" are u,µ,m,c,d,da,h,k,M,G,T or P",
+		},
+		{
+			"1nrpm",
+			"unknown unit prefix; valid prefixes for \"rpm\" are u,µ,m,c,d,da,h,k,M,G,T or P",
+		},
+		{
+			"10Trpm",
+			"maximum value is 9.223Trpm",
+		},
+		{
+			"-10Tbpm",
+			"minimum value is -9.223Tbpm",
 		},
 		{
 			"++1Hz",
@@ -235,6 +268,12 @@ func TestFrequency_Set(t *testing.T) {
 	}
 }
 
+func TestFrequency_RPM(t *testing.T) {
+	if v := Frequency(50 * Hertz).RPM(); v != 3000. {
+		t.Fatal(v)
+	}
+}
+
 func TestFrequency_RoundTrip(t *testing.T) {
 	x := 123 * Hertz
 	var y Frequency
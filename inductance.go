@@ -0,0 +1,141 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Inductance is a measurement of the opposition of a conductor to a change of
+// the electric current flowing through it stored as an int64 nano Henry.
+//
+// The highest representable value is 9.2GH.
+type Inductance int64
+
+// String returns the inductance formatted as a string in Henry.
+func (l Inductance) String() string {
+	return formatSI(int64(l), nano, siFormat{}) + "H"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (l Inductance) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(l), "nH", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(l), nano, siPrecision(prec)), 'H')
+	})
+}
+
+// FormatUnit returns the inductance formatted in the unit symbol "H" with an
+// optional SI prefix, rounded to precision digits after the decimal point. A
+// negative precision uses as many digits as necessary to represent the
+// inductance exactly.
+func (l Inductance) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(l), symbol, precision, scaledUnit{"H", int64(Henry), 1})
+}
+
+// Set sets the Inductance to the value represented by s. Units are to be
+// provided in "H" with an optional SI prefix: "p", "n", "u", "µ", "m", "c",
+// "d", "da", "h", "k", "M", "G" or "T".
+func (l *Inductance) Set(s string) error {
+	v, n, err := valueOfUnitString(s, nano, nanoPrefixes, "H")
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s, "H"); found != "" {
+					return err
+				}
+				return notNumberUnitErr("H")
+			case errOverflowsInt64:
+				return maxValueErr(maxInductance.String())
+			case errOverflowsInt64Negative:
+				return minValueErr(minInductance.String())
+			case errRoundsToZero:
+				return resolutionErr(NanoHenry.String())
+			}
+		}
+		return err
+	}
+
+	switch s[n:] {
+	case "H":
+		*l = (Inductance)(v)
+	case "":
+		return noUnitErr("H")
+	default:
+		if found := hasSuffixes(s[n:], "H"); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("H")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The Inductance is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (l Inductance) MarshalJSON() ([]byte, error) {
+	return marshalJSON(l.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano henries.
+func (l *Inductance) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, l.Set, func(v int64) error {
+		*l = Inductance(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Inductance to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (l Inductance) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(l), nano, siFormat{mode: siExact}), 'H'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Inductance is encoded as
+// appended by AppendText.
+func (l Inductance) MarshalText() ([]byte, error) {
+	return l.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (l *Inductance) UnmarshalText(text []byte) error {
+	return l.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Inductance is stored as an integer of
+// nano henries.
+func (l Inductance) Value() (driver.Value, error) {
+	return int64(l), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano henries or
+// text in a format understood by Set.
+func (l *Inductance) Scan(src any) error {
+	return scanValue(src, l.Set, func(v int64) error {
+		*l = Inductance(v)
+		return nil
+	})
+}
+
+const (
+	// Henry is Wb/A, kg⋅m²⋅s⁻²⋅A⁻².
+	NanoHenry  Inductance = 1
+	MicroHenry Inductance = 1000 * NanoHenry
+	MilliHenry Inductance = 1000 * MicroHenry
+	Henry      Inductance = 1000 * MilliHenry
+	KiloHenry  Inductance = 1000 * Henry
+	MegaHenry  Inductance = 1000 * KiloHenry
+	GigaHenry  Inductance = 1000 * MegaHenry
+
+	maxInductance = 9223372036854775807 * NanoHenry
+	minInductance = -9223372036854775807 * NanoHenry
+)
@@ -0,0 +1,152 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import "testing"
+
+func TestInductance_String(t *testing.T) {
+	if s := NanoHenry.String(); s != "1nH" {
+		t.Fatalf("%v", s)
+	}
+	if s := MicroHenry.String(); s != "1µH" {
+		t.Fatalf("%v", s)
+	}
+	if s := MilliHenry.String(); s != "1mH" {
+		t.Fatalf("%v", s)
+	}
+	if s := Henry.String(); s != "1H" {
+		t.Fatalf("%v", s)
+	}
+	if s := KiloHenry.String(); s != "1kH" {
+		t.Fatalf("%v", s)
+	}
+	if s := MegaHenry.String(); s != "1MH" {
+		t.Fatalf("%v", s)
+	}
+	if s := GigaHenry.String(); s != "1GH" {
+		t.Fatalf("%v", s)
+	}
+}
+
+func TestInductance_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected Inductance
+	}{
+		{"1nH", 1 * NanoHenry},
+		{"10nH", 10 * NanoHenry},
+		{"100nH", 100 * NanoHenry},
+		{"1uH", 1 * MicroHenry},
+		{"1µH", 1 * MicroHenry},
+		{"4.7µH", 4700 * NanoHenry},
+		{"1mH", 1 * MilliHenry},
+		{"100mH", 100 * MilliHenry},
+		{"1H", 1 * Henry},
+		{"10H", 10 * Henry},
+		{"1kH", 1 * KiloHenry},
+		{"1MH", 1 * MegaHenry},
+		{"1GH", 1 * GigaHenry},
+		{"12.345H", 12345 * MilliHenry},
+		{"-12.345H", -12345 * MilliHenry},
+		{"9.223372036854775807GH", 9223372036854775807 * NanoHenry},
+		{"-9.223372036854775807GH", -9223372036854775807 * NanoHenry},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10TH",
+			"maximum value is 9.223GH",
+		},
+		{
+			"10EH",
+			"unknown unit prefix; valid prefixes for \"H\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10ExaH",
+			"unknown unit prefix; valid prefixes for \"H\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10",
+			"no unit provided; need H",
+		},
+		{
+			"9223372036854775808",
+			"maximum value is 9.223GH",
+		},
+		{
+			"-9223372036854775808",
+			"minimum value is -9.223GH",
+		},
+		{
+			"9.223372036854775808GH",
+			"maximum value is 9.223GH",
+		},
+		{
+			"-9.223372036854775808GH",
+			"minimum value is -9.223GH",
+		},
+		{
+			"1random",
+			"unknown unit provided; need H",
+		},
+		{
+			"H",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit H",
+		},
+		{
+			"++1H",
+			"contains multiple plus symbols",
+		},
+		{
+			"--1H",
+			"contains multiple minus symbols",
+		},
+		{
+			"+-1H",
+			"contains both plus and minus symbols",
+		},
+		{
+			"1.1.1.1H",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got Inductance
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: Inductance.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: Inductance.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got Inductance
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: Inductance.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestInductance_RoundTrip(t *testing.T) {
+	x := 470 * MicroHenry
+	var y Inductance
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("Inductance.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("Inductance expected %s to equal %s", x, y)
+	}
+}
@@ -6,6 +6,11 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
 // LuminousFlux is a measurement of total quantity of visible light energy
 // emitted with wavelength power weighted by a luminosity function which
 // represents a model of the human eye's response to different wavelengths.
@@ -18,14 +23,30 @@ type LuminousFlux int64
 
 // String returns the energy formatted as a string in Lumens.
 func (f LuminousFlux) String() string {
-	return nanoAsString(int64(f)) + "lm"
+	return formatSI(int64(f), nano, siFormat{}) + "lm"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (f LuminousFlux) Format(s fmt.State, verb rune) {
+	formatQuantity(s, verb, int64(f), "nlm", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(f), nano, siPrecision(prec)), "lm"...)
+	})
+}
+
+// FormatUnit returns the flux formatted in the unit symbol "lm" with an
+// optional SI prefix, rounded to precision digits after the decimal point. A
+// negative precision uses as many digits as necessary to represent the flux
+// exactly.
+func (f LuminousFlux) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(f), symbol, precision, scaledUnit{"lm", int64(Lumen), 1})
 }
 
-// Set sets the LuminousFlux to the value represented by s. Units are to
-// be provided in "lm" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// Set sets the LuminousFlux to the value represented by s. Units are to be
+// provided in "lm" with an optional SI prefix: "p", "n", "u", "µ", "m", "c",
+// "d", "da", "h", "k", "M", "G" or "T".
 func (f *LuminousFlux) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	v, n, err := valueOfUnitString(s, nano, nanoPrefixes, "lm")
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
@@ -38,6 +59,8 @@ func (f *LuminousFlux) Set(s string) error {
 				return maxValueErr(maxLuminousFlux.String())
 			case errOverflowsInt64Negative:
 				return minValueErr(minLuminousFlux.String())
+			case errRoundsToZero:
+				return resolutionErr(NanoLumen.String())
 			}
 		}
 		return err
@@ -50,7 +73,7 @@ func (f *LuminousFlux) Set(s string) error {
 		return noUnitErr("lm")
 	default:
 		if found := hasSuffixes(s[n:], "lm"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
 		return incorrectUnitErr("lm")
 	}
@@ -58,6 +81,55 @@ func (f *LuminousFlux) Set(s string) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The LuminousFlux is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (f LuminousFlux) MarshalJSON() ([]byte, error) {
+	return marshalJSON(f.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano lumens.
+func (f *LuminousFlux) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, f.Set, func(v int64) error {
+		*f = LuminousFlux(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the LuminousFlux to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (f LuminousFlux) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(f), nano, siFormat{mode: siExact}), "lm"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The LuminousFlux is encoded as
+// appended by AppendText.
+func (f LuminousFlux) MarshalText() ([]byte, error) {
+	return f.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (f *LuminousFlux) UnmarshalText(text []byte) error {
+	return f.Set(string(text))
+}
+
+// Value implements driver.Valuer. The LuminousFlux is stored as an integer of
+// nano lumens.
+func (f LuminousFlux) Value() (driver.Value, error) {
+	return int64(f), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano lumens or
+// text in a format understood by Set.
+func (f *LuminousFlux) Scan(src any) error {
+	return scanValue(src, f.Set, func(v int64) error {
+		*f = LuminousFlux(v)
+		return nil
+	})
+}
+
 const (
 	// Lumen is a unit of luminous flux. cd⋅sr
 	NanoLumen  LuminousFlux = 1
@@ -76,11 +76,11 @@ func TestLuminousFlux_Set(t *testing.T) {
 		},
 		{
 			"10Elm",
-			"unknown unit prefix; valid prefixes for \"lm\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"lm\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10Exalm",
-			"unknown unit prefix; valid prefixes for \"lm\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"lm\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10elmE",
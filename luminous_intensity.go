@@ -6,6 +6,11 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
 // LuminousIntensity is a measurement of the quantity of visible light energy
 // emitted per unit solid angle with wavelength power weighted by a luminosity
 // function which represents the human eye's response to different wavelengths.
@@ -20,14 +25,30 @@ type LuminousIntensity int64
 
 // String returns the energy formatted as a string in Candela.
 func (i LuminousIntensity) String() string {
-	return nanoAsString(int64(i)) + "cd"
+	return formatSI(int64(i), nano, siFormat{}) + "cd"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (i LuminousIntensity) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(i), "ncd", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(i), nano, siPrecision(prec)), "cd"...)
+	})
+}
+
+// FormatUnit returns the intensity formatted in the unit symbol "cd" with an
+// optional SI prefix, rounded to precision digits after the decimal point. A
+// negative precision uses as many digits as necessary to represent the
+// intensity exactly.
+func (i LuminousIntensity) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(i), symbol, precision, scaledUnit{"cd", int64(Candela), 1})
 }
 
-// Set sets the LuminousIntensity to the value represented by s. Units are to
-// be provided in "cd" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// Set sets the LuminousIntensity to the value represented by s. Units are to be
+// provided in "cd" with an optional SI prefix: "p", "n", "u", "µ", "m", "c",
+// "d", "da", "h", "k", "M", "G" or "T".
 func (i *LuminousIntensity) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	v, n, err := valueOfUnitString(s, nano, nanoPrefixes, "cd")
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
@@ -40,6 +61,8 @@ func (i *LuminousIntensity) Set(s string) error {
 				return maxValueErr(maxLuminousIntensity.String())
 			case errOverflowsInt64Negative:
 				return minValueErr(minLuminousIntensity.String())
+			case errRoundsToZero:
+				return resolutionErr(NanoCandela.String())
 			}
 		}
 		return err
@@ -52,7 +75,7 @@ func (i *LuminousIntensity) Set(s string) error {
 		return noUnitErr("cd")
 	default:
 		if found := hasSuffixes(s[n:], "cd"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
 		return incorrectUnitErr("cd")
 	}
@@ -60,6 +83,55 @@ func (i *LuminousIntensity) Set(s string) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The LuminousIntensity is encoded as a
+// JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (i LuminousIntensity) MarshalJSON() ([]byte, error) {
+	return marshalJSON(i.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano candelas.
+func (i *LuminousIntensity) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, i.Set, func(v int64) error {
+		*i = LuminousIntensity(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the LuminousIntensity
+// to b exactly, without the rounding of String, so that Set parses the text
+// back to the same value. It does not allocate when b has enough capacity.
+func (i LuminousIntensity) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(i), nano, siFormat{mode: siExact}), "cd"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The LuminousIntensity is
+// encoded as appended by AppendText.
+func (i LuminousIntensity) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (i *LuminousIntensity) UnmarshalText(text []byte) error {
+	return i.Set(string(text))
+}
+
+// Value implements driver.Valuer. The LuminousIntensity is stored as an integer
+// of nano candelas.
+func (i LuminousIntensity) Value() (driver.Value, error) {
+	return int64(i), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano candelas or
+// text in a format understood by Set.
+func (i *LuminousIntensity) Scan(src any) error {
+	return scanValue(src, i.Set, func(v int64) error {
+		*i = LuminousIntensity(v)
+		return nil
+	})
+}
+
 const (
 	// Candela is a unit of luminous intensity. cd
 	NanoCandela  LuminousIntensity = 1
@@ -50,9 +50,11 @@ func TestLuminousIntensity_Set(t *testing.T) {
 		{"10mcd", 10 * MilliCandela},
 		{"100mcd", 100 * MilliCandela},
 		{"1cd", 1 * Candela},
+		{"1mcd", 1 * MilliCandela},
+		{"1kcd", 1000 * Candela},
 		{"10cd", 10 * Candela},
 		{"100cd", 100 * Candela},
-		{"1kcd", 1 * KiloCandela},
+		{"1kcd", 1000 * Candela},
 		{"10kcd", 10 * KiloCandela},
 		{"100kcd", 100 * KiloCandela},
 		{"1Mcd", 1 * MegaCandela},
@@ -76,11 +78,11 @@ func TestLuminousIntensity_Set(t *testing.T) {
 		},
 		{
 			"10Ecd",
-			"unknown unit prefix; valid prefixes for \"cd\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"cd\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10Exacd",
-			"unknown unit prefix; valid prefixes for \"cd\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"cd\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10ecdE",
@@ -0,0 +1,254 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+// MagneticFlux is a measurement of the magnetic field passing through a
+// surface, stored as an int64 nano Weber.
+//
+// The highest representable value is 9.2GWb.
+type MagneticFlux int64
+
+// String returns the magnetic flux formatted as a string in Weber.
+func (m MagneticFlux) String() string {
+	return formatSI(int64(m), nano, siFormat{}) + "Wb"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (m MagneticFlux) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(m), "nWb", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(m), nano, siPrecision(prec)), "Wb"...)
+	})
+}
+
+// FormatUnit returns the magnetic flux formatted in one of the units accepted
+// by Set, "Wb" or "Mx", with an optional SI prefix, rounded to precision digits
+// after the decimal point. A negative precision uses as many digits as
+// necessary to represent the magnetic flux exactly.
+func (m MagneticFlux) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(m), symbol, precision,
+		scaledUnit{"Wb", int64(Weber), 1},
+		scaledUnit{"Mx", int64(Maxwell), 1},
+	)
+}
+
+// Set sets the MagneticFlux to the value represented by s. Units are to be
+// provided in "Wb" or "Mx" (maxwell) with an optional SI prefix. "Wb" accepts
+// "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T" and "Mx"
+// accepts "m" to "E". A lone "Mx" is maxwell and not the mega prefix.
+func (m *MagneticFlux) Set(s string) error {
+	d, n, err := atod(s)
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s[n:], magneticFluxUnits...); found != "" {
+					return err
+				}
+				return notNumberUnitErr("Wb or Mx")
+			case errOverflowsInt64:
+				return maxValueErr(maxMagneticFlux.String())
+			case errOverflowsInt64Negative:
+				return minValueErr(minMagneticFlux.String())
+			}
+		}
+		return err
+	}
+
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "Wb", "Mx":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], prefixRange{pico, exa})
+			n += siSize
+		}
+	}
+	if r := magneticFluxPrefixes(s[n:]); si != unit && !r.contains(si) && slices.Contains(magneticFluxUnits, s[n:]) {
+		return unknownUnitPrefixErr(s[n:], r)
+	}
+
+	switch s[n:] {
+	case "Wb":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minMagneticFlux.String())
+			}
+			return maxValueErr(maxMagneticFlux.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoWeber.String())
+		}
+		*m = (MagneticFlux)(v)
+	case "Mx":
+		// 1Mx is 10⁻⁸Wb, or 10nWb.
+		v, overflow := dtoi(d, int(si-nano)-8)
+		if overflow {
+			if d.neg {
+				return minValueErr("-" + strconv.FormatInt(int64(maxMaxwell), 10) + "Mx")
+			}
+			return maxValueErr(strconv.FormatInt(int64(maxMaxwell), 10) + "Mx")
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoWeber.String())
+		}
+		*m = (MagneticFlux)(v)
+	case "":
+		return noUnitErr("Wb or Mx")
+	default:
+		if found := hasSuffixes(s[n:], magneticFluxUnits...); found != "" {
+			return unknownUnitPrefixErr(found, magneticFluxPrefixes(found))
+		}
+		return incorrectUnitErr("Wb or Mx")
+	}
+
+	return nil
+}
+
+// magneticFluxUnits are the units accepted by MagneticFlux.Set.
+var magneticFluxUnits = []string{"Wb", "Mx"}
+
+// magneticFluxPrefixes returns the SI prefixes accepted by MagneticFlux.Set
+// before unit, those with which a value between 0.001 and 1000 of the prefixed
+// unit is representable.
+func magneticFluxPrefixes(unit string) prefixRange {
+	if unit == "Mx" {
+		return prefixRange{milli, exa}
+	}
+	return nanoPrefixes
+}
+
+// MarshalJSON implements json.Marshaler. The MagneticFlux is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (m MagneticFlux) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano webers.
+func (m *MagneticFlux) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, m.Set, func(v int64) error {
+		*m = MagneticFlux(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the MagneticFlux to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (m MagneticFlux) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(m), nano, siFormat{mode: siExact}), "Wb"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The MagneticFlux is encoded as
+// appended by AppendText.
+func (m MagneticFlux) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (m *MagneticFlux) UnmarshalText(text []byte) error {
+	return m.Set(string(text))
+}
+
+// Value implements driver.Valuer. The MagneticFlux is stored as an integer of
+// nano webers.
+func (m MagneticFlux) Value() (driver.Value, error) {
+	return int64(m), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano webers or
+// text in a format understood by Set.
+func (m *MagneticFlux) Scan(src any) error {
+	return scanValue(src, m.Set, func(v int64) error {
+		*m = MagneticFlux(v)
+		return nil
+	})
+}
+
+// Wb returns the magnetic flux as a floating number of Weber.
+func (m MagneticFlux) Wb() float64 {
+	return float64(m) / float64(Weber)
+}
+
+// Mx returns the magnetic flux as a floating number of maxwells.
+func (m MagneticFlux) Mx() float64 {
+	return float64(m) / float64(Maxwell)
+}
+
+// Mul returns the magnetic flux of the uniform flux density b through a
+// perpendicular surface of area a, b×a. The result saturates at the highest
+// representable MagneticFlux.
+func (b MagneticFluxDensity) Mul(a Area) MagneticFlux {
+	if a < 0 {
+		b, a = -b, -a
+	}
+	// One nano tesla times one square millimetre is a millionth of a nano
+	// weber.
+	v, overflow := mulDiv(int64(b), int64(a), 1000000)
+	if overflow {
+		if b < 0 {
+			return minMagneticFlux
+		}
+		return maxMagneticFlux
+	}
+	return MagneticFlux(v)
+}
+
+// Mul returns the magnetic flux linked by the inductor l carrying the current
+// c, l×c. The result saturates at the highest representable MagneticFlux.
+func (l Inductance) Mul(c ElectricCurrent) MagneticFlux {
+	if c < 0 {
+		l, c = -l, -c
+	}
+	// One nano henry times one nano ampere is a billionth of a nano weber.
+	v, overflow := mulDiv(int64(l), int64(c), 1000000000)
+	if overflow {
+		if l < 0 {
+			return minMagneticFlux
+		}
+		return maxMagneticFlux
+	}
+	return MagneticFlux(v)
+}
+
+const (
+	// Weber is V⋅s, T⋅m², kg⋅m²⋅s⁻²⋅A⁻¹.
+	NanoWeber  MagneticFlux = 1
+	MicroWeber MagneticFlux = 1000 * NanoWeber
+	MilliWeber MagneticFlux = 1000 * MicroWeber
+	Weber      MagneticFlux = 1000 * MilliWeber
+	KiloWeber  MagneticFlux = 1000 * Weber
+	MegaWeber  MagneticFlux = 1000 * KiloWeber
+	GigaWeber  MagneticFlux = 1000 * MegaWeber
+
+	// Maxwell is the CGS unit of magnetic flux, 10⁻⁸Wb, or 1G⋅cm².
+	Maxwell MagneticFlux = 10 * NanoWeber
+
+	maxMagneticFlux = 9223372036854775807 * NanoWeber
+	minMagneticFlux = -9223372036854775807 * NanoWeber
+
+	// Maximum Maxwell is 922337203685477580Mx.
+	maxMaxwell = 922337203685477580
+)
@@ -6,29 +6,59 @@
 
 package unit
 
-// MagneticFluxDensity is a measurement of magnetic flux density, stored in Tesla.
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+// MagneticFluxDensity is a measurement of magnetic flux density, stored as an
+// int64 nano Tesla.
 //
 // The highest representable value is 9.2GT.
 type MagneticFluxDensity int64
 
-// String returns the energy formatted as a string in Farad.
+// String returns the magnetic flux density formatted as a string in Tesla.
 func (c MagneticFluxDensity) String() string {
-	return nanoAsString(int64(c)) + "T"
+	return formatSI(int64(c), nano, siFormat{}) + "T"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (c MagneticFluxDensity) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(c), "nT", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(c), nano, siPrecision(prec)), 'T')
+	})
+}
+
+// FormatUnit returns the flux density formatted in one of the units accepted by
+// Set, "T" or "G", with an optional SI prefix, rounded to precision digits
+// after the decimal point. A negative precision uses as many digits as
+// necessary to represent the flux density exactly.
+func (c MagneticFluxDensity) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(c), symbol, precision,
+		scaledUnit{"T", int64(Tesla), 1},
+		scaledUnit{"G", int64(Gauss), 1},
+	)
 }
 
-// Set sets the MagneticFluxDensity to the value represented by s. Units are
-// to be provided in "T" with an optional SI prefix: "p", "n", "u", "µ", "m",
-// "k", "M", "G" or "T".
+// Set sets the MagneticFluxDensity to the value represented by s. Units are to
+// be provided in "T" or "G" (gauss) with an optional SI prefix. "T" accepts
+// "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T" and "G"
+// accepts "u" to "P". A lone "G" is gauss and not the giga prefix.
 func (c *MagneticFluxDensity) Set(s string) error {
-	v, n, err := valueOfUnitString(s, pico)
+	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s, "T", "t"); found != "" {
+				if found := hasSuffixes(s[n:], magneticFluxDensityUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("T")
+				return notNumberUnitErr("T or G")
 			case errOverflowsInt64:
 				return maxValueErr(maxMagneticFluxDensity.String())
 			case errOverflowsInt64Negative:
@@ -38,21 +68,136 @@ func (c *MagneticFluxDensity) Set(s string) error {
 		return err
 	}
 
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "T", "t", "G":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], prefixRange{pico, peta})
+			n += siSize
+		}
+	}
+	if r := magneticFluxDensityPrefixes(s[n:]); si != unit && !r.contains(si) && slices.Contains(magneticFluxDensityUnits, s[n:]) {
+		return unknownUnitPrefixErr(s[n:], r)
+	}
+
 	switch s[n:] {
 	case "T", "t":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minMagneticFluxDensity.String())
+			}
+			return maxValueErr(maxMagneticFluxDensity.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoTesla.String())
+		}
+		*c = (MagneticFluxDensity)(v)
+	case "G":
+		// 1G is 10⁻⁴T, or 10⁵nT.
+		v, overflow := dtoi(d, int(si-nano)-4)
+		if overflow {
+			if d.neg {
+				return minValueErr("-" + strconv.FormatInt(int64(maxGauss), 10) + "G")
+			}
+			return maxValueErr(strconv.FormatInt(int64(maxGauss), 10) + "G")
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoTesla.String())
+		}
 		*c = (MagneticFluxDensity)(v)
 	case "":
-		return noUnitErr("T")
+		return noUnitErr("T or G")
 	default:
-		if found := hasSuffixes(s[n:], "T", "t"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], magneticFluxDensityUnits...); found != "" {
+			return unknownUnitPrefixErr(found, magneticFluxDensityPrefixes(found))
 		}
-		return incorrectUnitErr("T")
+		return incorrectUnitErr("T or G")
 	}
 
 	return nil
 }
 
+// magneticFluxDensityUnits are the units accepted by MagneticFluxDensity.Set.
+var magneticFluxDensityUnits = []string{"T", "t", "G"}
+
+// magneticFluxDensityPrefixes returns the SI prefixes accepted by
+// MagneticFluxDensity.Set before unit, those with which a value between 0.001
+// and 1000 of the prefixed unit is representable.
+func magneticFluxDensityPrefixes(unit string) prefixRange {
+	if unit == "G" {
+		return prefixRange{micro, peta}
+	}
+	return nanoPrefixes
+}
+
+// MarshalJSON implements json.Marshaler. The MagneticFluxDensity is encoded as
+// a JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (c MagneticFluxDensity) MarshalJSON() ([]byte, error) {
+	return marshalJSON(c.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano teslas.
+func (c *MagneticFluxDensity) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, c.Set, func(v int64) error {
+		*c = MagneticFluxDensity(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the
+// MagneticFluxDensity to b exactly, without the rounding of String, so that Set
+// parses the text back to the same value. It does not allocate when b has
+// enough capacity.
+func (c MagneticFluxDensity) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(c), nano, siFormat{mode: siExact}), 'T'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The MagneticFluxDensity is
+// encoded as appended by AppendText.
+func (c MagneticFluxDensity) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (c *MagneticFluxDensity) UnmarshalText(text []byte) error {
+	return c.Set(string(text))
+}
+
+// Value implements driver.Valuer. The MagneticFluxDensity is stored as an
+// integer of nano teslas.
+func (c MagneticFluxDensity) Value() (driver.Value, error) {
+	return int64(c), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano teslas or
+// text in a format understood by Set.
+func (c *MagneticFluxDensity) Scan(src any) error {
+	return scanValue(src, c.Set, func(v int64) error {
+		*c = MagneticFluxDensity(v)
+		return nil
+	})
+}
+
+// T returns the magnetic flux density as a floating number of Tesla.
+func (c MagneticFluxDensity) T() float64 {
+	return float64(c) / float64(Tesla)
+}
+
+// Gauss returns the magnetic flux density as a floating number of gauss.
+func (c MagneticFluxDensity) Gauss() float64 {
+	return float64(c) / float64(Gauss)
+}
+
 const (
 	// Tesla is a unit of magnetic flux density.
 	NanoTesla  MagneticFluxDensity = 1
@@ -63,6 +208,14 @@ const (
 	MegaTesla  MagneticFluxDensity = 1000 * KiloTesla
 	GigaTesla  MagneticFluxDensity = 1000 * MegaTesla
 
+	// Gauss is the CGS unit of magnetic flux density, 10⁻⁴T.
+	MilliGauss MagneticFluxDensity = 100 * NanoTesla
+	Gauss      MagneticFluxDensity = 1000 * MilliGauss
+	KiloGauss  MagneticFluxDensity = 1000 * Gauss
+
 	maxMagneticFluxDensity = 9223372036854775807 * NanoTesla
 	minMagneticFluxDensity = -9223372036854775807 * NanoTesla
+
+	// Maximum Gauss is 92233720368547G.
+	maxGauss = 92233720368547
 )
@@ -0,0 +1,203 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import "testing"
+
+func TestMagneticFluxDensity_String(t *testing.T) {
+	if s := NanoTesla.String(); s != "1nT" {
+		t.Fatalf("%v", s)
+	}
+	if s := MicroTesla.String(); s != "1µT" {
+		t.Fatalf("%v", s)
+	}
+	if s := MilliTesla.String(); s != "1mT" {
+		t.Fatalf("%v", s)
+	}
+	if s := Tesla.String(); s != "1T" {
+		t.Fatalf("%v", s)
+	}
+	if s := KiloTesla.String(); s != "1kT" {
+		t.Fatalf("%v", s)
+	}
+	if s := MegaTesla.String(); s != "1MT" {
+		t.Fatalf("%v", s)
+	}
+	if s := GigaTesla.String(); s != "1GT" {
+		t.Fatalf("%v", s)
+	}
+	if s := Gauss.String(); s != "100µT" {
+		t.Fatalf("%v", s)
+	}
+}
+
+func TestMagneticFluxDensity_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected MagneticFluxDensity
+	}{
+		{"1nT", 1 * NanoTesla},
+		{"10nT", 10 * NanoTesla},
+		{"100nT", 100 * NanoTesla},
+		{"1uT", 1 * MicroTesla},
+		{"1µT", 1 * MicroTesla},
+		{"45µT", 45 * MicroTesla},
+		{"1mT", 1 * MilliTesla},
+		{"1T", 1 * Tesla},
+		{"1t", 1 * Tesla},
+		{"1kT", 1 * KiloTesla},
+		{"1MT", 1 * MegaTesla},
+		{"1GT", 1 * GigaTesla},
+		{"12.345T", 12345 * MilliTesla},
+		{"-12.345T", -12345 * MilliTesla},
+		{"9.223372036854775807GT", 9223372036854775807 * NanoTesla},
+		{"-9.223372036854775807GT", -9223372036854775807 * NanoTesla},
+		{"1G", 1 * Gauss},
+		{"0.001PG", 100000000 * Tesla},
+		{"0.45G", 45 * MicroTesla},
+		{"-0.45G", -45 * MicroTesla},
+		{"1mG", 1 * MilliGauss},
+		{"250mG", 25 * MicroTesla},
+		{"1kG", 1 * KiloGauss},
+		{"10kG", 1 * Tesla},
+		{"92233720368547G", 9223372036854700000 * NanoTesla},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10TT",
+			"maximum value is 9.223GT",
+		},
+		{
+			"-10TT",
+			"minimum value is -9.223GT",
+		},
+		{
+			"10ET",
+			"unknown unit prefix; valid prefixes for \"T\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10ExaT",
+			"unknown unit prefix; valid prefixes for \"T\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10EG",
+			"unknown unit prefix; valid prefixes for \"G\" are u,µ,m,c,d,da,h,k,M,G,T or P",
+		},
+		{
+			"1pG",
+			"unknown unit prefix; valid prefixes for \"G\" are u,µ,m,c,d,da,h,k,M,G,T or P",
+		},
+		{
+			"10eTeslaE",
+			"unknown unit provided; need T or G",
+		},
+		{
+			"10",
+			"no unit provided; need T or G",
+		},
+		{
+			"9223372036854775808",
+			"maximum value is 9.223GT",
+		},
+		{
+			"-9223372036854775808",
+			"minimum value is -9.223GT",
+		},
+		{
+			"9.223372036854775808GT",
+			"maximum value is 9.223GT",
+		},
+		{
+			"-9.223372036854775808GT",
+			"minimum value is -9.223GT",
+		},
+		{
+			"92233720368548G",
+			"maximum value is 92233720368547G",
+		},
+		{
+			"-100TG",
+			"minimum value is -92233720368547G",
+		},
+		{
+			"1random",
+			"unknown unit provided; need T or G",
+		},
+		{
+			"T",
+			"not a number",
+		},
+		{
+			"G",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit T or G",
+		},
+		{
+			"++1T",
+			"contains multiple plus symbols",
+		},
+		{
+			"--1T",
+			"contains multiple minus symbols",
+		},
+		{
+			"+-1T",
+			"contains both plus and minus symbols",
+		},
+		{
+			"1.1.1.1T",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got MagneticFluxDensity
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: MagneticFluxDensity.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: MagneticFluxDensity.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got MagneticFluxDensity
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: MagneticFluxDensity.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestMagneticFluxDensity_RoundTrip(t *testing.T) {
+	x := 123 * MicroTesla
+	var y MagneticFluxDensity
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("MagneticFluxDensity.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("MagneticFluxDensity expected %s to equal %s", x, y)
+	}
+}
+
+func TestMagneticFluxDensity_T(t *testing.T) {
+	if v := MagneticFluxDensity(123 * Tesla).T(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestMagneticFluxDensity_Gauss(t *testing.T) {
+	if v := MagneticFluxDensity(123 * Gauss).Gauss(); v != 123. {
+		t.Fatal(v)
+	}
+}
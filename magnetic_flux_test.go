@@ -0,0 +1,212 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import "testing"
+
+func TestMagneticFlux_String(t *testing.T) {
+	if s := NanoWeber.String(); s != "1nWb" {
+		t.Fatalf("%v", s)
+	}
+	if s := MicroWeber.String(); s != "1µWb" {
+		t.Fatalf("%v", s)
+	}
+	if s := MilliWeber.String(); s != "1mWb" {
+		t.Fatalf("%v", s)
+	}
+	if s := Weber.String(); s != "1Wb" {
+		t.Fatalf("%v", s)
+	}
+	if s := KiloWeber.String(); s != "1kWb" {
+		t.Fatalf("%v", s)
+	}
+	if s := MegaWeber.String(); s != "1MWb" {
+		t.Fatalf("%v", s)
+	}
+	if s := GigaWeber.String(); s != "1GWb" {
+		t.Fatalf("%v", s)
+	}
+	if s := Maxwell.String(); s != "10nWb" {
+		t.Fatalf("%v", s)
+	}
+}
+
+func TestMagneticFlux_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected MagneticFlux
+	}{
+		{"1nWb", 1 * NanoWeber},
+		{"10nWb", 10 * NanoWeber},
+		{"1uWb", 1 * MicroWeber},
+		{"1µWb", 1 * MicroWeber},
+		{"1mWb", 1 * MilliWeber},
+		{"1Wb", 1 * Weber},
+		{"10Wb", 10 * Weber},
+		{"1kWb", 1 * KiloWeber},
+		{"1MWb", 1 * MegaWeber},
+		{"1GWb", 1 * GigaWeber},
+		{"12.345Wb", 12345 * MilliWeber},
+		{"-12.345Wb", -12345 * MilliWeber},
+		{"9.223372036854775807GWb", 9223372036854775807 * NanoWeber},
+		{"-9.223372036854775807GWb", -9223372036854775807 * NanoWeber},
+		{"1Mx", 1 * Maxwell},
+		{"0.001EMx", 10000000 * Weber},
+		{"0.1Mx", 1 * NanoWeber},
+		{"1kMx", 10 * MicroWeber},
+		{"1MMx", 10 * MilliWeber},
+		{"-5Mx", -50 * NanoWeber},
+		{"922337203685477580Mx", 9223372036854775800 * NanoWeber},
+		{"-922337203685477580Mx", -9223372036854775800 * NanoWeber},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10TWb",
+			"maximum value is 9.223GWb",
+		},
+		{
+			"10EWb",
+			"unknown unit prefix; valid prefixes for \"Wb\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10EMx",
+			"maximum value is 922337203685477580Mx",
+		},
+		{
+			"1pMx",
+			"unknown unit prefix; valid prefixes for \"Mx\" are m,c,d,da,h,k,M,G,T,P or E",
+		},
+		{
+			"10",
+			"no unit provided; need Wb or Mx",
+		},
+		{
+			"9223372036854775808",
+			"maximum value is 9.223GWb",
+		},
+		{
+			"-9223372036854775808",
+			"minimum value is -9.223GWb",
+		},
+		{
+			"922337203685477581Mx",
+			"maximum value is 922337203685477580Mx",
+		},
+		{
+			"-922337203685477581Mx",
+			"minimum value is -922337203685477580Mx",
+		},
+		{
+			"1random",
+			"unknown unit provided; need Wb or Mx",
+		},
+		{
+			"Wb",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit Wb or Mx",
+		},
+		{
+			"++1Wb",
+			"contains multiple plus symbols",
+		},
+		{
+			"1.1.1.1Mx",
+			"contains multiple decimal points",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got MagneticFlux
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: MagneticFlux.Set(%s) got unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: MagneticFlux.Set(%s) expected: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got MagneticFlux
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: MagneticFlux.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestMagneticFlux_Wb(t *testing.T) {
+	if v := MagneticFlux(123 * Weber).Wb(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestMagneticFlux_Mx(t *testing.T) {
+	if v := MagneticFlux(123 * Maxwell).Mx(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestMagneticFluxDensity_Mul(t *testing.T) {
+	data := []struct {
+		b    MagneticFluxDensity
+		a    Area
+		want MagneticFlux
+	}{
+		{Tesla, SquareMetre, Weber},
+		{Gauss, SquareCentimetre, Maxwell},
+		{MilliTesla, SquareCentimetre, 100 * NanoWeber},
+		{-Tesla, 2 * SquareMetre, -2 * Weber},
+		{Tesla, -2 * SquareMetre, -2 * Weber},
+		{-Tesla, -2 * SquareMetre, 2 * Weber},
+		{NanoTesla, SquareMillimetre, 0},
+		{GigaTesla, SquareKilometre, maxMagneticFlux},
+		{-GigaTesla, SquareKilometre, minMagneticFlux},
+	}
+	for i, tt := range data {
+		if got := tt.b.Mul(tt.a); got != tt.want {
+			t.Errorf("#%d: %s.Mul(%s) expected: %s(%d) but got: %s(%d)", i, tt.b, tt.a, tt.want, tt.want, got, got)
+		}
+	}
+}
+
+func TestInductance_Mul(t *testing.T) {
+	data := []struct {
+		l    Inductance
+		c    ElectricCurrent
+		want MagneticFlux
+	}{
+		{Henry, Ampere, Weber},
+		{10 * MilliHenry, 2 * Ampere, 20 * MilliWeber},
+		{100 * MicroHenry, -500 * MilliAmpere, -50 * MicroWeber},
+		{-Henry, -Ampere, Weber},
+		{NanoHenry, NanoAmpere, 0},
+		{GigaHenry, GigaAmpere, maxMagneticFlux},
+		{-GigaHenry, GigaAmpere, minMagneticFlux},
+	}
+	for i, tt := range data {
+		if got := tt.l.Mul(tt.c); got != tt.want {
+			t.Errorf("#%d: %s.Mul(%s) expected: %s(%d) but got: %s(%d)", i, tt.l, tt.c, tt.want, tt.want, got, got)
+		}
+	}
+}
+
+func TestMagneticFlux_RoundTrip(t *testing.T) {
+	x := 123 * MilliWeber
+	var y MagneticFlux
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("MagneticFlux.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("MagneticFlux expected %s to equal %s", x, y)
+	}
+}
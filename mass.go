@@ -7,7 +7,9 @@
 package unit
 
 import (
+	"database/sql/driver"
 	"errors"
+	"fmt"
 	"strconv"
 	"unicode/utf8"
 )
@@ -21,22 +23,52 @@ type Mass int64
 
 // String returns the mass formatted as a string in gram.
 func (m Mass) String() string {
-	return nanoAsString(int64(m)) + "g"
+	return formatSI(int64(m), nano, siFormat{}) + "g"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (m Mass) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(m), "ng", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(m), nano, siPrecision(prec)), 'g')
+	})
+}
+
+// FormatUnit returns the mass formatted in one of the units accepted by Set,
+// for example "g", "lb", "st" or "ozt", with an optional SI prefix, rounded to
+// precision digits after the decimal point. A negative precision uses as many
+// digits as necessary to represent the mass exactly.
+func (m Mass) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(m), symbol, precision,
+		scaledUnit{"g", int64(Gram), 1},
+		scaledUnit{"lb", int64(PoundMass), 1},
+		scaledUnit{"ozt", int64(TroyOunce), 1},
+		scaledUnit{"oz", int64(OunceMass), 1},
+		scaledUnit{"st", int64(Stone), 1},
+		scaledUnit{"ShortTon", int64(ShortTon), 1},
+		scaledUnit{"shortton", int64(ShortTon), 1},
+		scaledUnit{"LongTon", int64(LongTon), 1},
+		scaledUnit{"longton", int64(LongTon), 1},
+		scaledUnit{"gr", int64(Grain), 1},
+		scaledUnit{"ct", int64(Carat), 1},
+		scaledUnit{"slug", 14593902937206, 1},
+	)
 }
 
 // Set sets the Mass to the value represented by s. Units are to be provided in
-// "g", "lb" or "oz" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// "g", "lb", "oz", "st" (stone), "ShortTon", "LongTon", "gr" (grain), "ozt"
+// (troy ounce), "ct" (carat) or "slug" with an optional SI prefix: "p", "n",
+// "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T".
 func (m *Mass) Set(s string) error {
 	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s[n:], "g", "lb", "oz"); found != "" {
+				if found := hasSuffixes(s[n:], massUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("g, lb or oz")
+				return notNumberUnitErr("g, lb, oz, st, ShortTon, LongTon, gr, ozt, ct or slug")
 			case errOverflowsInt64:
 				// TODO(maruel): Look for suffix, and reuse it.
 				return maxValueErr(maxMass.String())
@@ -54,11 +86,20 @@ func (m *Mass) Set(s string) error {
 		if r <= 1 || rsize == 0 {
 			return errors.New("unexpected end of string")
 		}
-		var siSize int
-		si, siSize = parseSIPrefix(r)
-		n += siSize
+		switch s[n:] {
+		case "g", "lb", "oz", "st", "ShortTon", "shortton", "LongTon", "longton", "gr", "ozt", "ct", "slug":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
 	}
 
+	// gramsPerUnit is the value of the unit in nano grams, and minUnit and
+	// maxUnit are the representable range in the unit.
+	var gramsPerUnit decimal
+	var minUnit, maxUnit Mass
 	switch s[n:] {
 	case "g":
 		v, overflow := dtoi(d, int(si-nano))
@@ -68,48 +109,115 @@ func (m *Mass) Set(s string) error {
 			}
 			return maxValueErr(maxMass.String())
 		}
-		*m = (Mass)(v)
-	case "lb":
-		gramsPerlb := decimal{
-			base: uint64(PoundMass),
-			exp:  0,
-			neg:  false,
-		}
-		lbs, _ := decimalMul(d, gramsPerlb)
-		v, overflow := dtoi(lbs, int(si))
-		if overflow {
-			if lbs.neg {
-				return minValueErr(strconv.FormatInt(int64(minPoundMass), 10) + "lb")
-			}
-			return maxValueErr(strconv.FormatInt(int64(maxPoundMass), 10) + "lb")
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoGram.String())
 		}
 		*m = (Mass)(v)
+		return nil
+	case "lb":
+		gramsPerUnit = decimal{base: uint64(PoundMass)}
+		minUnit, maxUnit = minPoundMass, maxPoundMass
 	case "oz":
-		gramsPerOz := decimal{
-			base: uint64(OunceMass),
-			exp:  0,
-			neg:  false,
-		}
-		oz, _ := decimalMul(d, gramsPerOz)
-		v, overflow := dtoi(oz, int(si))
-		if overflow {
-			if oz.neg {
-				return minValueErr(strconv.FormatInt(int64(minOunceMass), 10) + "oz")
-			}
-			return maxValueErr(strconv.FormatInt(int64(maxOunceMass), 10) + "oz")
-		}
-		*m = (Mass)(v)
+		gramsPerUnit = decimal{base: uint64(OunceMass)}
+		minUnit, maxUnit = minOunceMass, maxOunceMass
+	case "st":
+		gramsPerUnit = decimal{base: uint64(Stone)}
+		minUnit, maxUnit = minStone, maxStone
+	case "ShortTon", "shortton":
+		gramsPerUnit = decimal{base: uint64(ShortTon)}
+		minUnit, maxUnit = minShortTon, maxShortTon
+	case "LongTon", "longton":
+		gramsPerUnit = decimal{base: uint64(LongTon)}
+		minUnit, maxUnit = minLongTon, maxLongTon
+	case "gr":
+		gramsPerUnit = decimal{base: uint64(Grain)}
+		minUnit, maxUnit = minGrain, maxGrain
+	case "ozt":
+		gramsPerUnit = decimal{base: uint64(TroyOunce)}
+		minUnit, maxUnit = minTroyOunce, maxTroyOunce
+	case "ct":
+		gramsPerUnit = decimal{base: uint64(Carat)}
+		minUnit, maxUnit = minCarat, maxCarat
+	case "slug":
+		// The Slug constant is rounded, this is the value to 14 significant
+		// figures.
+		gramsPerUnit = decimal{base: 14593902937206}
+		minUnit, maxUnit = minSlug, maxSlug
 	case "":
-		return noUnitErr("g, lb or oz")
+		return noUnitErr("g, lb, oz, st, ShortTon, LongTon, gr, ozt, ct or slug")
 	default:
-		if found := hasSuffixes(s[n:], "g", "lb", "oz"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], massUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("g, lb, oz, st, ShortTon, LongTon, gr, ozt, ct or slug")
+	}
+	x, _ := decimalMul(d, gramsPerUnit)
+	v, overflow := dtoi(x, int(si))
+	if overflow {
+		if x.neg {
+			return minValueErr(strconv.FormatInt(int64(minUnit), 10) + s[n:])
 		}
-		return incorrectUnitErr("g, lb or oz")
+		return maxValueErr(strconv.FormatInt(int64(maxUnit), 10) + s[n:])
+	}
+	if roundsToZero(x, v) {
+		return resolutionErr(NanoGram.String())
 	}
+	*m = (Mass)(v)
 	return nil
 }
 
+// massUnits are the units accepted by Mass.Set.
+var massUnits = []string{"g", "lb", "ozt", "oz", "st", "ShortTon", "shortton", "LongTon", "longton", "gr", "ct", "slug"}
+
+// MarshalJSON implements json.Marshaler. The Mass is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (m Mass) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano grams.
+func (m *Mass) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, m.Set, func(v int64) error {
+		*m = Mass(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Mass to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (m Mass) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(m), nano, siFormat{mode: siExact}), 'g'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Mass is encoded as
+// appended by AppendText.
+func (m Mass) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (m *Mass) UnmarshalText(text []byte) error {
+	return m.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Mass is stored as an integer of nano
+// grams.
+func (m Mass) Value() (driver.Value, error) {
+	return int64(m), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano grams or
+// text in a format understood by Set.
+func (m *Mass) Scan(src any) error {
+	return scanValue(src, m.Set, func(v int64) error {
+		*m = Mass(v)
+		return nil
+	})
+}
+
 const (
 	NanoGram  Mass = 1
 	MicroGram Mass = 1000 * NanoGram
@@ -131,6 +239,20 @@ const (
 
 	Slug Mass = 14593903 * MilliGram
 
+	// Stone is 14 pounds, used for body weight in the UK and Ireland.
+	Stone Mass = 14 * PoundMass
+	// ShortTon is the US ton of 2000 pounds and LongTon the imperial ton of
+	// 2240 pounds.
+	ShortTon Mass = 2000 * PoundMass
+	LongTon  Mass = 2240 * PoundMass
+	// Grain is 1/7000 of a pound.
+	Grain Mass = 64798910 * NanoGram
+
+	// TroyOunce is used for precious metals. It is 480 grains.
+	TroyOunce Mass = 480 * Grain
+	// Carat is used for gemstones.
+	Carat Mass = 200 * MilliGram
+
 	maxMass Mass = (1 << 63) - 1
 	minMass Mass = -((1 << 63) - 1)
 
@@ -140,4 +262,21 @@ const (
 	// min and max Ounce mass are in oz.
 	minOunceMass Mass = -325344874
 	maxOunceMass Mass = 325344874
+
+	// min and max values of the other units are in the unit they are named
+	// after.
+	minStone     Mass = -1452432
+	maxStone     Mass = 1452432
+	minShortTon  Mass = -10167
+	maxShortTon  Mass = 10167
+	minLongTon   Mass = -9077
+	maxLongTon   Mass = 9077
+	minGrain     Mass = -142338382495
+	maxGrain     Mass = 142338382495
+	minTroyOunce Mass = -296538296
+	maxTroyOunce Mass = 296538296
+	minCarat     Mass = -46116860184
+	maxCarat     Mass = 46116860184
+	minSlug      Mass = -632001
+	maxSlug      Mass = 632001
 )
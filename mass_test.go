@@ -39,6 +39,27 @@ func TestMass_Set(t *testing.T) {
 		{"-20334054lb", minPoundMass * PoundMass},
 		{"325344874oz", maxOunceMass * OunceMass},
 		{"-325344874oz", minOunceMass * OunceMass},
+		{"1st", Stone},
+		{"10.5st", 147 * PoundMass},
+		{"1ShortTon", ShortTon},
+		{"1shortton", 2000 * PoundMass},
+		{"1LongTon", LongTon},
+		{"1longton", 1016046908800000 * NanoGram},
+		{"1gr", Grain},
+		{"7000gr", PoundMass},
+		{"1ozt", TroyOunce},
+		{"1kozt", 31103476800000 * NanoGram},
+		{"1ct", Carat},
+		{"0.5ct", 100 * MilliGram},
+		{"1slug", 14593902937206 * NanoGram},
+		{"632001slug", 9223361250217129206 * NanoGram},
+		{"-632001slug", -9223361250217129206 * NanoGram},
+		{"1452432st", maxStone * Stone},
+		{"-1452432st", minStone * Stone},
+		{"10167ShortTon", maxShortTon * ShortTon},
+		{"9077LongTon", maxLongTon * LongTon},
+		{"296538296ozt", maxTroyOunce * TroyOunce},
+		{"46116860184ct", maxCarat * Carat},
 	}
 
 	fails := []struct {
@@ -47,11 +68,11 @@ func TestMass_Set(t *testing.T) {
 	}{
 		{
 			"10Eg",
-			"unknown unit prefix; valid prefixes for \"g\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"g\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10",
-			"no unit provided; need g, lb or oz",
+			"no unit provided; need g, lb, oz, st, ShortTon, LongTon, gr, ozt, ct or slug",
 		},
 		{
 			fmt.Sprintf("%dlb", maxPoundMass+1),
@@ -95,7 +116,7 @@ func TestMass_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need g, lb or oz",
+			"unknown unit provided; need g, lb, oz, st, ShortTon, LongTon, gr, ozt, ct or slug",
 		},
 		{
 			"g",
@@ -111,7 +132,7 @@ func TestMass_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit g, lb or oz",
+			"does not contain number or unit g, lb, oz, st, ShortTon, LongTon, gr, ozt, ct or slug",
 		},
 		{
 			"++1g",
@@ -141,6 +162,42 @@ func TestMass_Set(t *testing.T) {
 			"325344875oz",
 			"maximum value is 325344874oz",
 		},
+		{
+			fmt.Sprintf("%dst", maxStone+1),
+			fmt.Sprintf("maximum value is %dst", maxStone),
+		},
+		{
+			fmt.Sprintf("%dst", minStone-1),
+			fmt.Sprintf("minimum value is %dst", minStone),
+		},
+		{
+			fmt.Sprintf("%dShortTon", maxShortTon+1),
+			fmt.Sprintf("maximum value is %dShortTon", maxShortTon),
+		},
+		{
+			fmt.Sprintf("%dLongTon", minLongTon-1),
+			fmt.Sprintf("minimum value is %dLongTon", minLongTon),
+		},
+		{
+			fmt.Sprintf("%dgr", maxGrain+1000),
+			fmt.Sprintf("maximum value is %dgr", maxGrain),
+		},
+		{
+			fmt.Sprintf("%dozt", maxTroyOunce+1),
+			fmt.Sprintf("maximum value is %dozt", maxTroyOunce),
+		},
+		{
+			fmt.Sprintf("%dct", maxCarat+1),
+			fmt.Sprintf("maximum value is %dct", maxCarat),
+		},
+		{
+			fmt.Sprintf("%dslug", maxSlug+10),
+			fmt.Sprintf("maximum value is %dslug", maxSlug),
+		},
+		{
+			"10Est",
+			"unknown unit prefix; valid prefixes for \"st\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
 	}
 
 	for i, tt := range succeeds {
@@ -161,6 +218,20 @@ func TestMass_Set(t *testing.T) {
 	}
 }
 
+func TestMass_Slug(t *testing.T) {
+	// Slug is rounded to the nearest milligram.
+	var got Mass
+	if err := got.Set("1slug"); err != nil {
+		t.Fatal(err)
+	}
+	if d := got - Slug; d < -MilliGram/2 || d > MilliGram/2 {
+		t.Fatalf("Mass.Set(1slug) expected: %v but got: %v(%d)", Slug, got, got)
+	}
+	if s, err := Slug.FormatUnit("kg", 3); err != nil || s != "14.594kg" {
+		t.Fatalf("%s %v", s, err)
+	}
+}
+
 func TestMass_RoundTrip(t *testing.T) {
 	x := 123 * Gram
 	var y Mass
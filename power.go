@@ -6,6 +6,15 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
 // Power is a measurement of power stored as a nano watts.
 //
 // The highest representable value is 9.2GW.
@@ -13,22 +22,57 @@ type Power int64
 
 // String returns the power formatted as a string in watts.
 func (p Power) String() string {
-	return nanoAsString(int64(p)) + "W"
+	return formatSI(int64(p), nano, siFormat{}) + "W"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (p Power) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(p), "nW", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(p), nano, siPrecision(prec)), 'W')
+	})
 }
 
-// Set sets the Power to the value represented by s. Units are to
-// be provided in "W" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// FormatUnit returns the power formatted in one of the units accepted by Set,
+// "W", "hp", "PS", "BTU/h", "dBm" or "dBW", with an optional SI prefix for the
+// linear units, rounded to precision digits after the decimal point. A negative
+// precision uses as many digits as necessary to represent the power exactly,
+// or the shortest representation for the logarithmic units.
+func (p Power) FormatUnit(symbol string, precision int) (string, error) {
+	switch symbol {
+	case "dBm", "dBW":
+		if p <= 0 {
+			return "", errors.New("cannot express a power of " + p.String() + " in " + symbol)
+		}
+		x := p.DBm()
+		if symbol == "dBW" {
+			x -= 30
+		}
+		return strconv.FormatFloat(x, 'f', precision, 64) + symbol, nil
+	}
+	return formatUnit(int64(p), symbol, precision,
+		scaledUnit{"W", int64(Watt), 1},
+		scaledUnit{"hp", 74569987158227022, 100000},
+		scaledUnit{"PS", int64(MetricHorsepower), 1},
+		scaledUnit{"BTU/h", int64(BTU), 3600},
+	)
+}
+
+// Set sets the Power to the value represented by s. Units are to be provided in
+// "W", "hp" (mechanical horsepower), "PS" (metric horsepower) or "BTU/h" with
+// an optional SI prefix: "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k",
+// "M", "G" or "T". The logarithmic units "dBm" and "dBW" are also accepted,
+// without a prefix.
 func (p *Power) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s, "W", "w"); found != "" {
+				if found := hasSuffixes(s[n:], powerUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("W")
+				return notNumberUnitErr("W, hp, PS, BTU/h, dBm or dBW")
 			case errOverflowsInt64:
 				return maxValueErr(maxPower.String())
 			case errOverflowsInt64Negative:
@@ -38,21 +82,166 @@ func (p *Power) Set(s string) error {
 		return err
 	}
 
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "W", "w", "hp", "PS", "BTU/h", "dBm", "dBW":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
+	}
+
+	// factor is the value of the unit in nano watts and maxUnit is the largest
+	// representable value in the unit.
+	var factor decimal
+	var maxUnit int64
 	switch s[n:] {
 	case "W", "w":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minPower.String())
+			}
+			return maxValueErr(maxPower.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoWatt.String())
+		}
 		*p = (Power)(v)
+		return nil
+	case "hp":
+		factor, maxUnit = decimal{base: 74569987158227022, exp: -5}, maxHorsepower
+	case "PS":
+		factor, maxUnit = decimal{base: 73549875, exp: 4}, maxMetricHorsepower
+	case "BTU/h":
+		factor, maxUnit = decimal{base: 293072222222222, exp: -6}, maxBTUPerHour
+	case "dBm", "dBW":
+		if si != unit {
+			return errors.New("\"" + s[n:] + "\" does not accept an SI prefix")
+		}
+		x, err := strconv.ParseFloat(s[:n], 64)
+		if err != nil {
+			return err
+		}
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return errors.New("\"" + s[:n] + "\" is not a finite number")
+		}
+		// One watt is 30dBm.
+		max := maxDBm
+		if s[n:] == "dBW" {
+			x += 30
+			max -= 30
+		}
+		if x > maxDBm {
+			return maxValueErr(strconv.FormatFloat(max, 'f', 3, 64) + s[n:])
+		}
+		v := FromDBm(x)
+		if v == 0 {
+			// A finite logarithmic value is never zero watts.
+			return resolutionErr(NanoWatt.String())
+		}
+		*p = v
+		return nil
 	case "":
-		return noUnitErr("W")
+		return noUnitErr("W, hp, PS, BTU/h, dBm or dBW")
 	default:
-		if found := hasSuffixes(s[n:], "W", "w"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], powerUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
-		return incorrectUnitErr("W")
+		return incorrectUnitErr("W, hp, PS, BTU/h, dBm or dBW")
 	}
-
+	v, lossy, overflow := dtoiScaled(d, factor, si)
+	if lossy {
+		return errors.New("converting to nano watts would overflow, consider using nW for maximum precision")
+	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + strconv.FormatInt(maxUnit, 10) + s[n:])
+		}
+		return maxValueErr(strconv.FormatInt(maxUnit, 10) + s[n:])
+	}
+	if roundsToZero(d, v) {
+		return resolutionErr(NanoWatt.String())
+	}
+	*p = (Power)(v)
 	return nil
 }
 
+// powerUnits are the units accepted by Power.Set.
+var powerUnits = []string{"W", "w", "hp", "PS", "BTU/h", "dBm", "dBW"}
+
+// MarshalJSON implements json.Marshaler. The Power is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (p Power) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano watts.
+func (p *Power) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, p.Set, func(v int64) error {
+		*p = Power(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Power to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (p Power) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(p), nano, siFormat{mode: siExact}), 'W'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Power is encoded as
+// appended by AppendText.
+func (p Power) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (p *Power) UnmarshalText(text []byte) error {
+	return p.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Power is stored as an integer of nano
+// watts.
+func (p Power) Value() (driver.Value, error) {
+	return int64(p), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano watts or
+// text in a format understood by Set.
+func (p *Power) Scan(src any) error {
+	return scanValue(src, p.Set, func(v int64) error {
+		*p = Power(v)
+		return nil
+	})
+}
+
+// DBm returns the power as a floating number of decibel-milliwatts. It returns
+// -Inf for a zero power and NaN for a negative one.
+func (p Power) DBm() float64 {
+	return 10 * math.Log10(float64(p)/float64(MilliWatt))
+}
+
+// FromDBm returns the Power represented by dbm decibel-milliwatts, rounded to
+// the nearest nano watt. Values above the highest representable Power saturate
+// to it.
+func FromDBm(dbm float64) Power {
+	if dbm > maxDBm {
+		return maxPower
+	}
+	return Power(math.Round(float64(MilliWatt) * math.Pow(10, dbm/10)))
+}
+
 const (
 	// Watt is unit of power J/s, kg⋅m²⋅s⁻³
 	NanoWatt  Power = 1
@@ -63,6 +252,24 @@ const (
 	MegaWatt  Power = 1000 * KiloWatt
 	GigaWatt  Power = 1000 * MegaWatt
 
+	// Horsepower is the mechanical (imperial) horsepower of 550 ft⋅lbf/s.
+	Horsepower Power = 745699871582 * NanoWatt
+	// MetricHorsepower is the power needed to lift 75kg by one metre in one
+	// second.
+	MetricHorsepower Power = 735498750 * MicroWatt
+
+	// BTUPerHour is one BTU per hour, used to rate heating and cooling
+	// equipment.
+	BTUPerHour Power = 293072222 * NanoWatt
+
 	maxPower = 9223372036854775807 * NanoWatt
 	minPower = -9223372036854775807 * NanoWatt
+
+	// Maximum values in the unit they are named after.
+	maxHorsepower       = 12368745
+	maxMetricHorsepower = 12540296
+	maxBTUPerHour       = 31471328012
+
+	// maxDBm is maxPower in dBm.
+	maxDBm = 129.648897268
 )
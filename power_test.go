@@ -6,7 +6,10 @@
 
 package unit
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestPower_String(t *testing.T) {
 	if s := NanoWatt.String(); s != "1nW" {
@@ -66,6 +69,20 @@ func TestPower_Set(t *testing.T) {
 		{"9.223372036854775807GW", 9223372036854775807 * NanoWatt},
 		{"-9.223372036854775807GW", -9223372036854775807 * NanoWatt},
 		{"1MW", 1 * MegaWatt},
+		{"1hp", 745699871582 * NanoWatt},
+		{"-1hp", -745699871582 * NanoWatt},
+		{"1khp", 745699871582270 * NanoWatt},
+		{"1PS", 1 * MetricHorsepower},
+		{"2.5PS", 1838746875 * MicroWatt},
+		{"1BTU/h", 293072222 * NanoWatt},
+		{"12kBTU/h", 3516866666667 * NanoWatt},
+		{"0dBm", 1 * MilliWatt},
+		{"30dBm", 1 * Watt},
+		{"-30dBm", 1 * MicroWatt},
+		{"3dBm", 1995262 * NanoWatt},
+		{"0dBW", 1 * Watt},
+		{"-90dBW", 1 * NanoWatt},
+		{"60dBW", 1 * MegaWatt},
 	}
 
 	fails := []struct {
@@ -78,19 +95,19 @@ func TestPower_Set(t *testing.T) {
 		},
 		{
 			"10EW",
-			"unknown unit prefix; valid prefixes for \"W\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"W\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10ExaW",
-			"unknown unit prefix; valid prefixes for \"W\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"W\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10eWattE",
-			"unknown unit provided; need W",
+			"unknown unit provided; need W, hp, PS, BTU/h, dBm or dBW",
 		},
 		{
 			"10",
-			"no unit provided; need W",
+			"no unit provided; need W, hp, PS, BTU/h, dBm or dBW",
 		},
 		{
 			"9223372036854775808",
@@ -118,7 +135,7 @@ func TestPower_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need W",
+			"unknown unit provided; need W, hp, PS, BTU/h, dBm or dBW",
 		},
 		{
 			"W",
@@ -126,7 +143,7 @@ func TestPower_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit W",
+			"does not contain number or unit W, hp, PS, BTU/h, dBm or dBW",
 		},
 		{
 			"++1W",
@@ -144,6 +161,38 @@ func TestPower_Set(t *testing.T) {
 			"1.1.1.1W",
 			"contains multiple decimal points",
 		},
+		{
+			"12368746hp",
+			"maximum value is 12368745hp",
+		},
+		{
+			"-12540297PS",
+			"minimum value is -12540296PS",
+		},
+		{
+			"130dBm",
+			"maximum value is 129.649dBm",
+		},
+		{
+			"-300dBm",
+			"nonzero value rounds to zero; resolution is 1nW",
+		},
+		{
+			"-100dBW",
+			"nonzero value rounds to zero; resolution is 1nW",
+		},
+		{
+			"100dBW",
+			"maximum value is 99.649dBW",
+		},
+		{
+			"10mdBm",
+			"\"dBm\" does not accept an SI prefix",
+		},
+		{
+			"10Ehp",
+			"unknown unit prefix; valid prefixes for \"hp\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
 	}
 
 	for i, tt := range succeeds {
@@ -164,6 +213,50 @@ func TestPower_Set(t *testing.T) {
 	}
 }
 
+func TestPower_DBm(t *testing.T) {
+	data := []struct {
+		in       Power
+		expected float64
+	}{
+		{MilliWatt, 0},
+		{Watt, 30},
+		{MicroWatt, -30},
+		{100 * Watt, 50},
+	}
+	for i, line := range data {
+		if v := line.in.DBm(); math.Abs(v-line.expected) > 1e-9 {
+			t.Errorf("#%d: Power(%s).DBm() = %g != %g", i, line.in, v, line.expected)
+		}
+	}
+	if v := Power(0).DBm(); !math.IsInf(v, -1) {
+		t.Errorf("Power(0).DBm() = %g", v)
+	}
+}
+
+func TestFromDBm(t *testing.T) {
+	data := []struct {
+		in       float64
+		expected Power
+	}{
+		{0, MilliWatt},
+		{30, Watt},
+		{-30, MicroWatt},
+		{-60, NanoWatt},
+		{-100, 0},
+		{1000, maxPower},
+	}
+	for i, line := range data {
+		if v := FromDBm(line.in); v != line.expected {
+			t.Errorf("#%d: FromDBm(%g) = %s != %s", i, line.in, v, line.expected)
+		}
+	}
+	for _, p := range []Power{MilliWatt, 1995262 * NanoWatt, 5 * KiloWatt} {
+		if v := FromDBm(p.DBm()); v != p {
+			t.Errorf("FromDBm(%s.DBm()) = %s", p, v)
+		}
+	}
+}
+
 func TestPower_RoundTrip(t *testing.T) {
 	x := 123 * Watt
 	var y Power
@@ -6,6 +6,14 @@
 
 package unit
 
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
 // Pressure is a measurement of force applied to a surface per unit
 // area (stress) stored as an int64 nano Pascal.
 //
@@ -14,46 +22,184 @@ type Pressure int64
 
 // String returns the pressure formatted as a string in Pascal.
 func (p Pressure) String() string {
-	return nanoAsString(int64(p)) + "Pa"
+	return formatSI(int64(p), nano, siFormat{}) + "Pa"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (p Pressure) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(p), "nPa", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(p), nano, siPrecision(prec)), "Pa"...)
+	})
 }
 
-// Set sets the Pressure to the value represented by s. Units are to
-// be provided in "Pa" with an optional SI prefix: "p", "n", "u", "µ", "m", "k",
-// "M", "G" or "T".
+// FormatUnit returns the pressure formatted in one of the units accepted by
+// Set, "Pa", "bar", "atm", "psi", "mmHg", "inHg" or "Torr", with an optional
+// SI prefix such as the "h" of "hPa", rounded to precision digits after the
+// decimal point. A negative precision uses as many digits as necessary to
+// represent the pressure exactly.
+func (p Pressure) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(p), symbol, precision,
+		scaledUnit{"Pa", int64(Pascal), 1},
+		scaledUnit{"bar", int64(Bar), 1},
+		scaledUnit{"atm", int64(Atmosphere), 1},
+		// Same conversion factors as Set.
+		scaledUnit{"psi", 6894757293168361, 1000},
+		scaledUnit{"mmHg", 133322387415, 1},
+		scaledUnit{"inHg", 3386388640341, 1},
+		scaledUnit{"Torr", 133322368421, 1},
+	)
+}
+
+// Set sets the Pressure to the value represented by s. Units are to be provided
+// in "Pa", "bar", "atm", "psi", "mmHg", "inHg" or "Torr" with an optional SI
+// prefix: "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T",
+// for example "hPa".
 func (p *Pressure) Set(s string) error {
-	v, n, err := valueOfUnitString(s, nano)
+	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s, "Pa"); found != "" {
+				if found := hasSuffixes(s[n:], pressureUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("Pa")
+				return notNumberUnitErr("Pa, bar, atm, psi, mmHg, inHg or Torr")
 			case errOverflowsInt64:
+				// TODO(maruel): Look for suffix, and reuse it.
 				return maxValueErr(maxPressure.String())
 			case errOverflowsInt64Negative:
+				// TODO(maruel): Look for suffix, and reuse it.
 				return minValueErr(minPressure.String())
 			}
 		}
 		return err
 	}
 
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		switch s[n:] {
+		case "Pa", "bar", "atm", "psi", "mmHg", "inHg", "Torr":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
+	}
+
+	// factor is the value of the unit in nano Pascal and maxUnit is the largest
+	// representable value in the unit.
+	var factor decimal
+	var maxUnit int64
 	switch s[n:] {
 	case "Pa":
+		v, overflow := dtoi(d, int(si-nano))
+		if overflow {
+			if d.neg {
+				return minValueErr(minPressure.String())
+			}
+			return maxValueErr(maxPressure.String())
+		}
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoPascal.String())
+		}
 		*p = (Pressure)(v)
+		return nil
+	case "bar":
+		factor, maxUnit = decimal{base: 1, exp: 14}, maxBar
+	case "atm":
+		factor, maxUnit = decimal{base: 101325, exp: 9}, maxAtmosphere
+	case "psi":
+		factor, maxUnit = decimal{base: 6894757293168361, exp: -3}, maxPoundPerSquareInch
+	case "mmHg":
+		factor, maxUnit = decimal{base: 133322387415, exp: 0}, maxMillimetreOfMercury
+	case "inHg":
+		factor, maxUnit = decimal{base: 3386388640341, exp: 0}, maxInchOfMercury
+	case "Torr":
+		// 1Torr is exactly 101325/760Pa, rounded here to 12 significant figures.
+		factor, maxUnit = decimal{base: 133322368421, exp: 0}, maxTorr
 	case "":
-		return noUnitErr("Pa")
+		return noUnitErr("Pa, bar, atm, psi, mmHg, inHg or Torr")
 	default:
-		if found := hasSuffixes(s[n:], "Pa"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], pressureUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
-		return incorrectUnitErr("Pa")
+		return incorrectUnitErr("Pa, bar, atm, psi, mmHg, inHg or Torr")
 	}
-
+	v, lossy, overflow := dtoiScaled(d, factor, si)
+	if lossy {
+		return errors.New("converting to nano Pascals would overflow, consider using nPa for maximum precision")
+	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + strconv.FormatInt(maxUnit, 10) + s[n:])
+		}
+		return maxValueErr(strconv.FormatInt(maxUnit, 10) + s[n:])
+	}
+	if roundsToZero(d, v) {
+		return resolutionErr(NanoPascal.String())
+	}
+	*p = (Pressure)(v)
 	return nil
 }
 
+// pressureUnits are the units accepted by Pressure.Set.
+var pressureUnits = []string{"Pa", "bar", "atm", "psi", "mmHg", "inHg", "Torr"}
+
+// MarshalJSON implements json.Marshaler. The Pressure is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (p Pressure) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano pascals.
+func (p *Pressure) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, p.Set, func(v int64) error {
+		*p = Pressure(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Pressure to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (p Pressure) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(p), nano, siFormat{mode: siExact}), "Pa"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Pressure is encoded as
+// appended by AppendText.
+func (p Pressure) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (p *Pressure) UnmarshalText(text []byte) error {
+	return p.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Pressure is stored as an integer of nano
+// pascals.
+func (p Pressure) Value() (driver.Value, error) {
+	return int64(p), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano pascals or
+// text in a format understood by Set.
+func (p *Pressure) Scan(src any) error {
+	return scanValue(src, p.Set, func(v int64) error {
+		*p = Pressure(v)
+		return nil
+	})
+}
+
 // Pa returns the pressure as a floating number of Pascals.
 func (p Pressure) Pa() float64 {
 	return float64(p) / float64(Pascal)
@@ -74,6 +220,36 @@ func (p Pressure) Bar() float64 {
 	return float64(p) / float64(Bar)
 }
 
+// HPa returns the pressure as a floating number of HectoPascals.
+func (p Pressure) HPa() float64 {
+	return float64(p) / float64(HectoPascal)
+}
+
+// Atm returns the pressure as a floating number of standard atmospheres.
+func (p Pressure) Atm() float64 {
+	return float64(p) / float64(Atmosphere)
+}
+
+// PSI returns the pressure as a floating number of pounds per square inch.
+func (p Pressure) PSI() float64 {
+	return float64(p) / float64(PoundPerSquareInch)
+}
+
+// MmHg returns the pressure as a floating number of millimetres of mercury.
+func (p Pressure) MmHg() float64 {
+	return float64(p) / float64(MillimetreOfMercury)
+}
+
+// InHg returns the pressure as a floating number of inches of mercury.
+func (p Pressure) InHg() float64 {
+	return float64(p) / float64(InchOfMercury)
+}
+
+// Torr returns the pressure as a floating number of Torr.
+func (p Pressure) Torr() float64 {
+	return float64(p) / float64(Torr)
+}
+
 const (
 	// Pascal is N/m², kg/m/s².
 	NanoPascal  Pressure = 1
@@ -84,9 +260,31 @@ const (
 	MegaPascal  Pressure = 1000 * KiloPascal
 	GigaPascal  Pressure = 1000 * MegaPascal
 
-	MilliBar Pressure = 100 * Pascal
-	Bar      Pressure = 1000 * MilliBar
+	HectoPascal Pressure = 100 * Pascal
+	MilliBar    Pressure = 100 * Pascal
+	Bar         Pressure = 1000 * MilliBar
+
+	// Atmosphere is the standard atmosphere.
+	Atmosphere Pressure = 101325 * Pascal
+
+	// Conversion between Pascal and imperial units.
+	PoundPerSquareInch Pressure = 6894757293168 * NanoPascal
+
+	// Manometric units. The mercury column units use the conventional density
+	// of mercury and standard gravity.
+	MillimetreOfMercury Pressure = 133322387415 * NanoPascal
+	InchOfMercury       Pressure = 3386388640341 * NanoPascal
+	Torr                Pressure = 133322368421 * NanoPascal
 
 	maxPressure = 9223372036854775807 * NanoPascal
 	minPressure = -9223372036854775807 * NanoPascal
+
+	// Maximum values in the unit they are named after. They are untyped counts
+	// of that unit, not Pressures.
+	maxBar                 = 92233
+	maxAtmosphere          = 91027
+	maxPoundPerSquareInch  = 1337737
+	maxMillimetreOfMercury = 69180969
+	maxInchOfMercury       = 2723660
+	maxTorr                = 69180979
 )
@@ -64,6 +64,28 @@ func TestPressure_Set(t *testing.T) {
 		{"9.223372036854775807GPa", 9223372036854775807 * NanoPascal},
 		{"-9.223372036854775807GPa", -9223372036854775807 * NanoPascal},
 		{"1MPa", 1 * MegaPascal},
+		{"1013.25hPa", 101325 * Pascal},
+		{"1hPa", 1 * HectoPascal},
+		{"1bar", 1 * Bar},
+		{"1.5bar", 1500 * MilliBar},
+		{"1013.25mbar", 101325 * Pascal},
+		{"-1bar", -1 * Bar},
+		{"92233bar", 92233 * Bar},
+		{"1atm", 1 * Atmosphere},
+		{"0.5atm", 50662500 * MilliPascal},
+		{"1psi", 1 * PoundPerSquareInch},
+		{"32psi", 220632233381388 * NanoPascal},
+		{"1kpsi", 6894757293168361 * NanoPascal},
+		{"1mmHg", 1 * MillimetreOfMercury},
+		{"760mmHg", 101325014435400 * NanoPascal},
+		{"1inHg", 1 * InchOfMercury},
+		{"29.92inHg", 101320748119003 * NanoPascal},
+		{"1Torr", 1 * Torr},
+		{"760Torr", 101324999999960 * NanoPascal},
+		{"1mTorr", 133322368 * NanoPascal},
+		{"10hbar", 1000 * Bar},
+		{"1daPa", 10 * Pascal},
+		{"5cPa", 50 * MilliPascal},
 	}
 
 	fails := []struct {
@@ -76,19 +98,27 @@ func TestPressure_Set(t *testing.T) {
 		},
 		{
 			"10EPa",
-			"unknown unit prefix; valid prefixes for \"Pa\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"Pa\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"1khPa",
+			"unknown unit prefix; valid prefixes for \"Pa\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"1mhPa",
+			"unknown unit prefix; valid prefixes for \"Pa\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10ExaPa",
-			"unknown unit prefix; valid prefixes for \"Pa\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"Pa\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10ePascalE",
-			"unknown unit provided; need Pa",
+			"unknown unit provided; need Pa, bar, atm, psi, mmHg, inHg or Torr",
 		},
 		{
 			"10",
-			"no unit provided; need Pa",
+			"no unit provided; need Pa, bar, atm, psi, mmHg, inHg or Torr",
 		},
 		{
 			"9223372036854775808",
@@ -116,7 +146,7 @@ func TestPressure_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need Pa",
+			"unknown unit provided; need Pa, bar, atm, psi, mmHg, inHg or Torr",
 		},
 		{
 			"Pa",
@@ -124,7 +154,7 @@ func TestPressure_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit Pa",
+			"does not contain number or unit Pa, bar, atm, psi, mmHg, inHg or Torr",
 		},
 		{
 			"++1Pa",
@@ -142,6 +172,34 @@ func TestPressure_Set(t *testing.T) {
 			"1.1.1.1Pa",
 			"contains multiple decimal points",
 		},
+		{
+			"92234bar",
+			"maximum value is 92233bar",
+		},
+		{
+			"-92234bar",
+			"minimum value is -92233bar",
+		},
+		{
+			"1337738psi",
+			"maximum value is 1337737psi",
+		},
+		{
+			"69180970mmHg",
+			"maximum value is 69180969mmHg",
+		},
+		{
+			"1234567.890123456789psi",
+			"converting to nano Pascals would overflow, consider using nPa for maximum precision",
+		},
+		{
+			"10Epsi",
+			"unknown unit prefix; valid prefixes for \"psi\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10Ebar",
+			"unknown unit prefix; valid prefixes for \"bar\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
 	}
 
 	for i, tt := range succeeds {
@@ -196,3 +254,39 @@ func TestPressure_Bar(t *testing.T) {
 		t.Fatal(v)
 	}
 }
+
+func TestPressure_HPa(t *testing.T) {
+	if v := Pressure(1013 * HectoPascal).HPa(); v != 1013. {
+		t.Fatal(v)
+	}
+}
+
+func TestPressure_Atm(t *testing.T) {
+	if v := Pressure(2 * Atmosphere).Atm(); v != 2. {
+		t.Fatal(v)
+	}
+}
+
+func TestPressure_PSI(t *testing.T) {
+	if v := Pressure(32 * PoundPerSquareInch).PSI(); v != 32. {
+		t.Fatal(v)
+	}
+}
+
+func TestPressure_MmHg(t *testing.T) {
+	if v := Pressure(760 * MillimetreOfMercury).MmHg(); v != 760. {
+		t.Fatal(v)
+	}
+}
+
+func TestPressure_InHg(t *testing.T) {
+	if v := Pressure(30 * InchOfMercury).InHg(); v != 30. {
+		t.Fatal(v)
+	}
+}
+
+func TestPressure_Torr(t *testing.T) {
+	if v := Pressure(760 * Torr).Torr(); v != 760. {
+		t.Fatal(v)
+	}
+}
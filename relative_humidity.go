@@ -6,7 +6,11 @@
 
 package unit
 
-import "strconv"
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
 
 // RelativeHumidity is a humidity level measurement stored as an int32 fixed
 // point integer at a precision of 0.00001%rH.
@@ -16,23 +20,53 @@ type RelativeHumidity int32
 
 // String returns the humidity formatted as a string.
 func (r RelativeHumidity) String() string {
+	var buf [16]byte
+	return string(appendRelativeHumidity(buf[:0], r))
+}
+
+// appendRelativeHumidity appends the humidity to b as formatted by String.
+func appendRelativeHumidity(b []byte, r RelativeHumidity) []byte {
 	r /= MilliRH
 	frac := int(r % 10)
-	if frac == 0 {
-		return strconv.Itoa(int(r)/10) + "%rH"
-	}
-	if frac < 0 {
-		frac = -frac
+	b = strconv.AppendInt(b, int64(r)/10, 10)
+	if frac != 0 {
+		if frac < 0 {
+			frac = -frac
+		}
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(frac), 10)
 	}
-	return strconv.Itoa(int(r)/10) + "." + strconv.Itoa(frac) + "%rH"
+	return append(b, "%rH"...)
 }
 
-// Set sets the RelativeHumidity to the value represented by s. Units are to
-// be provided in "%rH" or "%" with an optional SI prefix: "p", "n", "u", "µ",
-// "m", "k", "M", "G" or "T".
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (r RelativeHumidity) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(r), "", func(b []byte, prec int) []byte {
+		if prec < 0 {
+			return appendRelativeHumidity(b, r)
+		}
+		return append(appendUnit(b, int64(r), micro+deca, unit, prec), "%rH"...)
+	})
+}
+
+// FormatUnit returns the humidity formatted in one of the units accepted by
+// Set, "%rH" or "%", with an optional SI prefix, rounded to precision digits
+// after the decimal point. A negative precision uses as many digits as
+// necessary to represent the humidity exactly.
+func (r RelativeHumidity) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(r), symbol, precision,
+		scaledUnit{"%rH", int64(PercentRH), 1},
+		scaledUnit{"%", int64(PercentRH), 1},
+	)
+}
+
+// Set sets the RelativeHumidity to the value represented by s. Units are to be
+// provided in "%rH" or "%" with an optional SI prefix: "u", "µ", "m", "c", "d",
+// "da", "h" or "k".
 func (r *RelativeHumidity) Set(s string) error {
 	// PercentRH is micro + deca.
-	v, n, err := valueOfUnitString(s, micro+deca)
+	v, n, err := valueOfUnitString(s, micro+deca, relativeHumidityPrefixes, "%rH", "%")
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
@@ -45,6 +79,8 @@ func (r *RelativeHumidity) Set(s string) error {
 				return maxValueErr(maxRelativeHumidity.String())
 			case errOverflowsInt64Negative:
 				return minValueErr(minRelativeHumidity.String())
+			case errRoundsToZero:
+				return resolutionErr("0.00001%rH")
 			}
 		}
 		return err
@@ -65,7 +101,7 @@ func (r *RelativeHumidity) Set(s string) error {
 		return noUnitErr("%rH or %")
 	default:
 		if found := hasSuffixes(s[n:], "%rH", "%"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+			return unknownUnitPrefixErr(found, relativeHumidityPrefixes)
 		}
 		return incorrectUnitErr("%rH or %")
 	}
@@ -73,6 +109,71 @@ func (r *RelativeHumidity) Set(s string) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler. The RelativeHumidity is encoded as a
+// JSON string of the text appended by AppendText, which UnmarshalJSON parses
+// back to the same value.
+func (r RelativeHumidity) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of 0.00001%rH steps.
+func (r *RelativeHumidity) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, r.Set, func(v int64) error {
+		if v > int64(maxRelativeHumidity) {
+			return maxValueErr(maxRelativeHumidity.String())
+		}
+		if v < int64(minRelativeHumidity) {
+			return minValueErr(minRelativeHumidity.String())
+		}
+		*r = RelativeHumidity(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the RelativeHumidity
+// to b exactly, without the rounding of String, so that Set parses the text
+// back to the same value. It does not allocate when b has enough capacity.
+func (r RelativeHumidity) AppendText(b []byte) ([]byte, error) {
+	return append(appendUnit(b, int64(r), micro+deca, unit, -1), "%rH"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The RelativeHumidity is
+// encoded as appended by AppendText.
+func (r RelativeHumidity) MarshalText() ([]byte, error) {
+	return r.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (r *RelativeHumidity) UnmarshalText(text []byte) error {
+	return r.Set(string(text))
+}
+
+// Value implements driver.Valuer. The RelativeHumidity is stored as an integer
+// of 0.00001%rH steps.
+func (r RelativeHumidity) Value() (driver.Value, error) {
+	return int64(r), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of 0.00001%rH steps
+// or text in a format understood by Set.
+func (r *RelativeHumidity) Scan(src any) error {
+	return scanValue(src, r.Set, func(v int64) error {
+		if v > int64(maxRelativeHumidity) {
+			return maxValueErr(maxRelativeHumidity.String())
+		}
+		if v < int64(minRelativeHumidity) {
+			return minValueErr(minRelativeHumidity.String())
+		}
+		*r = RelativeHumidity(v)
+		return nil
+	})
+}
+
+// relativeHumidityPrefixes are the SI prefixes accepted by
+// RelativeHumidity.Set, which is limited to 100%rH.
+var relativeHumidityPrefixes = prefixRange{micro, kilo}
+
 const (
 	TenthMicroRH RelativeHumidity = 1                 // 0.00001%rH
 	MicroRH      RelativeHumidity = 10 * TenthMicroRH // 0.0001%rH
@@ -62,7 +62,7 @@ func TestRelativeHumidity_Set(t *testing.T) {
 	}{
 		{
 			"10E%rH",
-			"unknown unit prefix; valid prefixes for \"%rH\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"%rH\" are u,µ,m,c,d,da,h or k",
 		},
 		{
 			"10",
@@ -77,13 +77,17 @@ func TestRelativeHumidity_Set(t *testing.T) {
 			"minimum value is 0%rH",
 		},
 		{
-			"90224T%rH",
+			"90224k%rH",
 			"maximum value is 100%rH",
 		},
 		{
-			"-90224T%rH",
+			"-90224k%rH",
 			"minimum value is 0%rH",
 		},
+		{
+			"1M%rH",
+			"unknown unit prefix; valid prefixes for \"%rH\" are u,µ,m,c,d,da,h or k",
+		},
 		{
 			"1random",
 			"unknown unit provided; need %rH or %",
@@ -7,8 +7,13 @@
 package unit
 
 import (
+	"database/sql/driver"
 	"errors"
+	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 	"unicode/utf8"
 )
 
@@ -20,22 +25,75 @@ type Speed int64
 
 // String returns the speed formatted as a string in m/s.
 func (sp Speed) String() string {
-	return nanoAsString(int64(sp)) + "m/s"
+	return formatSI(int64(sp), nano, siFormat{}) + "m/s"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (sp Speed) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(sp), "nm/s", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(sp), nano, siPrecision(prec)), "m/s"...)
+	})
+}
+
+// FormatUnit returns the speed formatted in one of the units accepted by Set,
+// "m/s", "mps", "kph", "fps", "mph", "kn", "kt", "fpm", "ft/min" or "Mach",
+// with an optional SI prefix, rounded to precision digits after the decimal
+// point. A negative precision uses as many digits as necessary to represent the
+// speed exactly.
+//
+// The symbols "/km" and "/mi" format the speed as a pace, as in "4:30/km", with
+// precision digits after the decimal point of the seconds.
+func (sp Speed) FormatUnit(symbol string, precision int) (string, error) {
+	if per, ok := paceDistance(symbol); ok {
+		if sp <= 0 {
+			return "", errors.New("cannot express a speed of " + sp.String() + " as a pace")
+		}
+		pace := sp.Pace(per)
+		if pace == math.MaxInt64 {
+			return "", errors.New("speed of " + sp.String() + " is too slow to be expressed as a pace")
+		}
+		return formatPace(pace, precision) + symbol, nil
+	}
+	return formatUnit(int64(sp), symbol, precision,
+		scaledUnit{"m/s", int64(MetrePerSecond), 1},
+		scaledUnit{"mps", int64(MetrePerSecond), 1},
+		scaledUnit{"kph", int64(KilometrePerHour), 1},
+		scaledUnit{"fps", int64(FootPerSecond), 1},
+		scaledUnit{"mph", int64(MilePerHour), 1},
+		// One knot is exactly 1852/3600m/s.
+		scaledUnit{"kn", 1852000000000, 3600},
+		scaledUnit{"kt", 1852000000000, 3600},
+		scaledUnit{"fpm", int64(FootPerMinute), 1},
+		scaledUnit{"ft/min", int64(FootPerMinute), 1},
+		scaledUnit{"Mach", int64(SpeedOfSound), 1},
+	)
 }
 
 // Set sets the Speed to the value represented by s. Units are to be provided in
-// "mps"(meters per second), "m/s", "kph", "fps", or "mph" with an optional SI
-// prefix: "p", "n", "u", "µ", "m", "k", "M", "G" or "T".
+// "mps"(meters per second), "m/s", "kph", "fps", "mph", "kn" or "kt" (knots),
+// "fpm" or "ft/min" (feet per minute) or "Mach" with an optional SI prefix:
+// "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T". Mach is
+// relative to SpeedOfSound and may also be written first, as in "Mach 0.8".
+//
+// A pace of minutes and seconds per kilometre or mile is also accepted, as in
+// "4:30/km" or "7:15/mi". Hours may precede the minutes, as in "1:02:30/mi".
 func (sp *Speed) Set(s string) error {
+	if per, ok := paceDistance(s); ok {
+		return sp.setPace(s[:strings.LastIndexByte(s, '/')], per)
+	}
+	if rest, ok := strings.CutPrefix(s, "Mach"); ok {
+		s = strings.TrimSpace(rest) + "Mach"
+	}
 	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s[n:], "m/s", "mps", "kph", "fps", "mph"); found != "" {
+				if found := hasSuffixes(s[n:], speedUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("m/s, mps, kph, fps or mph")
+				return notNumberUnitErr("m/s, mps, kph, fps, mph, kn, fpm or Mach")
 			case errOverflowsInt64:
 				// TODO(maruel): Look for suffix, and reuse it.
 				return maxValueErr(maxSpeed.String())
@@ -53,24 +111,20 @@ func (sp *Speed) Set(s string) error {
 		if r <= 1 || rsize == 0 {
 			return errors.New("unexpected end of string")
 		}
-		var siSize int
-		si, siSize = parseSIPrefix(r)
-		if si == milli {
-			switch s[n:] {
-			case "m/s", "mps", "mph":
-				si = unit
-				siSize = 0
-			}
-		}
-		if si == kilo {
-			switch s[n:] {
-			case "kph":
-				si = unit
-				siSize = 0
-			}
+		switch s[n:] {
+		case "m/s", "mps", "mph", "kph", "kn", "kt", "fpm", "ft/min", "Mach":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
 		}
-		n += siSize
 	}
+
+	// mpsPerUnit is the value of the unit in nano metres per second, and
+	// minUnit and maxUnit are the representable range in the unit.
+	var mpsPerUnit decimal
+	var minUnit, maxUnit Speed
 	switch s[n:] {
 	case "m/s", "mps":
 		v, overflow := dtoi(d, int(si-nano))
@@ -80,63 +134,234 @@ func (sp *Speed) Set(s string) error {
 			}
 			return maxValueErr(maxSpeed.String())
 		}
-		*sp = (Speed)(v)
-	case "kph":
-		mpsPerkph := decimal{
-			base: uint64(KilometrePerHour),
-			exp:  0,
-			neg:  false,
-		}
-		kph, _ := decimalMul(d, mpsPerkph)
-		v, overflow := dtoi(kph, int(si))
-		if overflow {
-			if kph.neg {
-				return minValueErr(strconv.FormatInt(int64(minKilometrePerHour), 10) + "kph")
-			}
-			return maxValueErr(strconv.FormatInt(int64(maxKilometrePerHour), 10) + "kph")
+		if roundsToZero(d, v) {
+			return resolutionErr(NanoMetrePerSecond.String())
 		}
 		*sp = (Speed)(v)
+		return nil
+	case "kph":
+		mpsPerUnit = decimal{base: uint64(KilometrePerHour)}
+		minUnit, maxUnit = minKilometrePerHour, maxKilometrePerHour
 	case "fps":
-		mpsPerfps := decimal{
-			base: uint64(FootPerSecond / 1000),
-			exp:  3,
-			neg:  false,
-		}
-		oz, _ := decimalMul(d, mpsPerfps)
-		v, overflow := dtoi(oz, int(si))
-		if overflow {
-			if oz.neg {
-				return minValueErr(strconv.FormatInt(int64(minFootPerSecond), 10) + "fps")
-			}
-			return maxValueErr(strconv.FormatInt(int64(maxFootPerSecond), 10) + "fps")
-		}
-		*sp = (Speed)(v)
+		mpsPerUnit = decimal{base: uint64(FootPerSecond / 1000), exp: 3}
+		minUnit, maxUnit = minFootPerSecond, maxFootPerSecond
 	case "mph":
-		mpsPermph := decimal{
-			base: uint64(MilePerHour / 1000),
-			exp:  3,
-			neg:  false,
-		}
-		oz, _ := decimalMul(d, mpsPermph)
-		v, overflow := dtoi(oz, int(si))
-		if overflow {
-			if oz.neg {
-				return minValueErr(strconv.FormatInt(int64(minMilePerHour), 10) + "mph")
-			}
-			return maxValueErr(strconv.FormatInt(int64(maxMilePerHour), 10) + "mph")
-		}
-		*sp = (Speed)(v)
+		mpsPerUnit = decimal{base: uint64(MilePerHour / 1000), exp: 3}
+		minUnit, maxUnit = minMilePerHour, maxMilePerHour
+	case "kn", "kt":
+		mpsPerUnit = decimal{base: 5144444444444444, exp: -7}
+		minUnit, maxUnit = minKnot, maxKnot
+	case "fpm", "ft/min":
+		mpsPerUnit = decimal{base: uint64(FootPerMinute / 10000), exp: 4}
+		minUnit, maxUnit = minFootPerMinute, maxFootPerMinute
+	case "Mach":
+		mpsPerUnit = decimal{base: uint64(SpeedOfSound / 1000000), exp: 6}
+		minUnit, maxUnit = minMach, maxMach
 	case "":
-		return noUnitErr("m/s, mps, kph, fps or mph")
+		return noUnitErr("m/s, mps, kph, fps, mph, kn, fpm or Mach")
 	default:
-		if found := hasSuffixes(s[n:], "m/s", "mps", "kph", "fps", "mph"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], speedUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("m/s, mps, kph, fps, mph, kn, fpm or Mach")
+	}
+	x, _ := decimalMul(d, mpsPerUnit)
+	v, overflow := dtoi(x, int(si))
+	if overflow {
+		if x.neg {
+			return minValueErr(strconv.FormatInt(int64(minUnit), 10) + s[n:])
+		}
+		return maxValueErr(strconv.FormatInt(int64(maxUnit), 10) + s[n:])
+	}
+	if roundsToZero(x, v) {
+		return resolutionErr(NanoMetrePerSecond.String())
+	}
+	*sp = (Speed)(v)
+	return nil
+}
+
+// speedUnits are the units accepted by Speed.Set.
+var speedUnits = []string{"m/s", "mps", "kph", "fps", "mph", "kn", "kt", "fpm", "ft/min", "Mach"}
+
+// paceDistance returns the distance of a pace ending with "/km" or "/mi".
+func paceDistance(s string) (Distance, bool) {
+	switch {
+	case strings.HasSuffix(s, "/km"):
+		return KiloMetre, true
+	case strings.HasSuffix(s, "/mi"):
+		return Mile, true
+	}
+	return 0, false
+}
+
+// setPace sets the Speed from a pace of [h:]m:ss[.fff] per distance.
+func (sp *Speed) setPace(s string, per Distance) error {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return errors.New("pace must be in minutes and seconds, as in 4:30/km")
+	}
+	var pace time.Duration
+	for i, part := range parts[:len(parts)-1] {
+		v, err := strconv.ParseUint(part, 10, 16)
+		if err != nil || (i > 0 && (v >= 60 || len(part) != 2)) {
+			return errors.New("invalid pace \"" + s + "\"")
 		}
-		return incorrectUnitErr("m/s, mps, kph, fps or mph")
+		pace = pace*60 + time.Duration(v)*time.Minute
+	}
+	secs := parts[len(parts)-1]
+	d, n, err := atod(secs)
+	if err != nil || n != len(secs) || d.neg || len(secs) < 2 || secs[0] == '+' {
+		return errors.New("invalid pace \"" + s + "\"")
+	}
+	v, overflow := dtoi(d, 9)
+	if overflow || v >= int64(time.Minute) {
+		return errors.New("invalid pace \"" + s + "\"")
 	}
+	pace += time.Duration(v)
+	if pace == 0 {
+		return errors.New("pace must be greater than zero")
+	}
+	x := SpeedFromPace(pace, per)
+	if x == 0 {
+		return errors.New("pace is too slow to be represented")
+	}
+	*sp = x
 	return nil
 }
 
+// formatPace formats a pace as [h:]m:ss with precision digits after the
+// decimal point of the seconds.
+func formatPace(pace time.Duration, precision int) string {
+	if precision < 0 {
+		precision = 0
+	}
+	if precision > 9 {
+		precision = 9
+	}
+	unit := time.Duration(powerOf10[9-precision])
+	// Rounding from the remainder cannot overflow, unlike adding unit/2.
+	pace, r := pace/unit, pace%unit
+	if r >= unit-r {
+		pace++
+	}
+	perSecond := time.Duration(powerOf10[precision])
+	secs, frac := pace/perSecond%60, pace%perSecond
+	mins := pace / perSecond / 60
+	var b []byte
+	if mins >= 60 {
+		b = strconv.AppendInt(b, int64(mins/60), 10)
+		b = append(b, ':')
+		b = appendPrefixZeros(b, 2, int(mins%60))
+	} else {
+		b = strconv.AppendInt(b, int64(mins), 10)
+	}
+	b = append(b, ':')
+	b = appendPrefixZeros(b, 2, int(secs))
+	if precision > 0 {
+		b = append(b, '.')
+		b = appendPrefixZeros(b, precision, int(frac))
+	}
+	return string(b)
+}
+
+// MarshalJSON implements json.Marshaler. The Speed is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (sp Speed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(sp.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano metres per second.
+func (sp *Speed) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, sp.Set, func(v int64) error {
+		*sp = Speed(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Speed to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (sp Speed) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(sp), nano, siFormat{mode: siExact}), "m/s"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Speed is encoded as
+// appended by AppendText.
+func (sp Speed) MarshalText() ([]byte, error) {
+	return sp.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (sp *Speed) UnmarshalText(text []byte) error {
+	return sp.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Speed is stored as an integer of nano
+// metres per second.
+func (sp Speed) Value() (driver.Value, error) {
+	return int64(sp), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano metres per
+// second or text in a format understood by Set.
+func (sp *Speed) Scan(src any) error {
+	return scanValue(src, sp.Set, func(v int64) error {
+		*sp = Speed(v)
+		return nil
+	})
+}
+
+// Mach returns the speed as a floating number of multiples of SpeedOfSound.
+func (sp Speed) Mach() float64 {
+	return float64(sp) / float64(SpeedOfSound)
+}
+
+// Pace returns the time taken to travel the distance d at this speed, as used
+// for running or cycling, for example sp.Pace(unit.KiloMetre).
+//
+// A 0m/s speed returns a 0s pace. A pace that cannot be represented as a
+// Duration saturates.
+func (sp Speed) Pace(d Distance) time.Duration {
+	if sp == 0 {
+		return 0
+	}
+	if sp < 0 {
+		sp, d = -sp, -d
+	}
+	v, overflow := mulDiv(int64(d), int64(time.Second), int64(sp))
+	if overflow {
+		if d < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return time.Duration(v)
+}
+
+// SpeedFromPace returns the speed needed to travel the distance per in the
+// duration pace, for example SpeedFromPace(4*time.Minute, unit.KiloMetre).
+//
+// A 0s pace returns a 0m/s speed. A speed that cannot be represented
+// saturates.
+func SpeedFromPace(pace time.Duration, per Distance) Speed {
+	if pace == 0 {
+		return 0
+	}
+	if pace < 0 {
+		pace, per = -pace, -per
+	}
+	v, overflow := mulDiv(int64(per), int64(time.Second), int64(pace))
+	if overflow {
+		if per < 0 {
+			return minSpeed
+		}
+		return maxSpeed
+	}
+	return Speed(v)
+}
+
 const (
 	// MetrePerSecond is m/s.
 	NanoMetrePerSecond  Speed = 1
@@ -152,6 +377,14 @@ const (
 	KilometrePerHour Speed = 277777778 * NanoMetrePerSecond
 	MilePerHour      Speed = 447040 * MicroMetrePerSecond
 	FootPerSecond    Speed = 304800 * MicroMetrePerSecond
+	FootPerMinute    Speed = 5080 * MicroMetrePerSecond
+	// Knot is one nautical mile per hour.
+	Knot Speed = 514444444 * NanoMetrePerSecond
+
+	// SpeedOfSound is the speed of sound in dry air at 15°C, as in the
+	// International Standard Atmosphere at sea level. It is the reference for
+	// Mach numbers.
+	SpeedOfSound Speed = 340294 * MilliMetrePerSecond
 
 	maxSpeed Speed = (1 << 63) - 1
 	minSpeed Speed = -((1 << 63) - 1)
@@ -165,4 +398,13 @@ const (
 	// Min Max FootPerSecond are in fps.
 	minFootPerSecond Speed = -30260406945
 	maxFootPerSecond Speed = 30260406945
+	// Min Max Knot are in kn.
+	minKnot Speed = -17928800935
+	maxKnot Speed = 17928800935
+	// Min Max FootPerMinute are in fpm.
+	minFootPerMinute Speed = -1815624416703
+	maxFootPerMinute Speed = 1815624416703
+	// Min Max Mach are in Mach.
+	minMach Speed = -27104127
+	maxMach Speed = 27104127
 )
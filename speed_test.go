@@ -8,7 +8,9 @@ package unit
 
 import (
 	"fmt"
+	"math"
 	"testing"
+	"time"
 )
 
 func TestSpeed_String(t *testing.T) {
@@ -50,6 +52,22 @@ func TestSpeed_Set(t *testing.T) {
 		{fmt.Sprintf("%dmph", maxMilePerHour), maxMilePerHour * MilePerHour},
 		{fmt.Sprintf("%dfps", minFootPerSecond), minFootPerSecond * FootPerSecond},
 		{fmt.Sprintf("%dfps", maxFootPerSecond), maxFootPerSecond * FootPerSecond},
+		{"1kn", Knot},
+		{"1kt", Knot},
+		{"10kn", 5144444444 * NanoMetrePerSecond},
+		{"-3600kn", -1852 * MetrePerSecond},
+		{"1fpm", FootPerMinute},
+		{"100ft/min", 508 * MilliMetrePerSecond},
+		{"1Mach", SpeedOfSound},
+		{"Mach 0.8", 272235200 * MicroMetrePerSecond},
+		{"Mach2", 680588 * MilliMetrePerSecond},
+		{"4:00/km", 4166666667 * NanoMetrePerSecond},
+		{"5:00/km", 3333333333 * NanoMetrePerSecond},
+		{"4:30.5/km", 3696857671 * NanoMetrePerSecond},
+		{"6:00/mi", 4470400 * MicroMetrePerSecond},
+		{"7:15/mi", 3699641379 * NanoMetrePerSecond},
+		{"1:00:00/mi", MilePerHour},
+		{fmt.Sprintf("%dkn", maxKnot), 9223372031164977360 * NanoMetrePerSecond},
 	}
 
 	fails := []struct {
@@ -62,11 +80,11 @@ func TestSpeed_Set(t *testing.T) {
 		},
 		{
 			"10Em/s",
-			"unknown unit prefix; valid prefixes for \"m/s\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"m/s\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10",
-			"no unit provided; need m/s, mps, kph, fps or mph",
+			"no unit provided; need m/s, mps, kph, fps, mph, kn, fpm or Mach",
 		},
 		{
 			fmt.Sprintf("%dkph", maxKilometrePerHour+1),
@@ -110,7 +128,7 @@ func TestSpeed_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need m/s, mps, kph, fps or mph",
+			"unknown unit provided; need m/s, mps, kph, fps, mph, kn, fpm or Mach",
 		},
 		{
 			"m/s",
@@ -130,7 +148,7 @@ func TestSpeed_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit m/s, mps, kph, fps or mph",
+			"does not contain number or unit m/s, mps, kph, fps, mph, kn, fpm or Mach",
 		},
 		{
 			"++1m/s",
@@ -152,6 +170,42 @@ func TestSpeed_Set(t *testing.T) {
 			string([]byte{0x33, 0x01}),
 			"unexpected end of string",
 		},
+		{
+			fmt.Sprintf("%dkn", maxKnot+100),
+			fmt.Sprintf("maximum value is %dkn", maxKnot),
+		},
+		{
+			fmt.Sprintf("%dMach", minMach-1),
+			fmt.Sprintf("minimum value is %dMach", minMach),
+		},
+		{
+			"10Ekn",
+			"unknown unit prefix; valid prefixes for \"kn\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"4/km",
+			"pace must be in minutes and seconds, as in 4:30/km",
+		},
+		{
+			"4:60/km",
+			"invalid pace \"4:60\"",
+		},
+		{
+			"4:5/km",
+			"invalid pace \"4:5\"",
+		},
+		{
+			"-4:30/km",
+			"invalid pace \"-4:30\"",
+		},
+		{
+			"1:60:00/mi",
+			"invalid pace \"1:60:00\"",
+		},
+		{
+			"0:00/km",
+			"pace must be greater than zero",
+		},
 	}
 
 	for i, tt := range succeeds {
@@ -172,6 +226,53 @@ func TestSpeed_Set(t *testing.T) {
 	}
 }
 
+func TestSpeed_Pace(t *testing.T) {
+	data := []struct {
+		in       Speed
+		per      Distance
+		expected time.Duration
+	}{
+		{0, KiloMetre, 0},
+		{MetrePerSecond, KiloMetre, 1000 * time.Second},
+		{MetrePerSecond, Metre, time.Second},
+		{3333333333 * NanoMetrePerSecond, KiloMetre, 300000000030 * time.Nanosecond},
+		{MilePerHour, Mile, time.Hour},
+		{-MetrePerSecond, KiloMetre, -1000 * time.Second},
+		{NanoMetrePerSecond, GigaMetre, math.MaxInt64},
+	}
+	for i, line := range data {
+		if v := line.in.Pace(line.per); v != line.expected {
+			t.Errorf("#%d: Speed(%s).Pace(%s) = %s != %s", i, line.in, line.per, v, line.expected)
+		}
+	}
+}
+
+func TestSpeedFromPace(t *testing.T) {
+	data := []struct {
+		in       time.Duration
+		per      Distance
+		expected Speed
+	}{
+		{0, KiloMetre, 0},
+		{4 * time.Minute, KiloMetre, 4166666667 * NanoMetrePerSecond},
+		{time.Hour, Mile, MilePerHour},
+		{time.Hour, NauticalMile, Knot},
+		{-time.Second, Metre, -MetrePerSecond},
+		{time.Nanosecond, GigaMetre, maxSpeed},
+	}
+	for i, line := range data {
+		if v := SpeedFromPace(line.in, line.per); v != line.expected {
+			t.Errorf("#%d: SpeedFromPace(%s, %s) = %s != %s", i, line.in, line.per, v, line.expected)
+		}
+	}
+}
+
+func TestSpeed_Mach(t *testing.T) {
+	if v := Speed(2 * SpeedOfSound).Mach(); v != 2. {
+		t.Fatal(v)
+	}
+}
+
 func TestSpeed_RoundTrip(t *testing.T) {
 	x := 123 * MetrePerSecond
 	var y Speed
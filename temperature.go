@@ -7,7 +7,9 @@
 package unit
 
 import (
+	"database/sql/driver"
 	"errors"
+	"fmt"
 	"strconv"
 	"unicode/utf8"
 )
@@ -21,25 +23,70 @@ type Temperature int64
 
 // String returns the temperature formatted as a string in °Celsius.
 func (t Temperature) String() string {
+	var buf [24]byte
+	return string(appendTemperature(buf[:0], t, siFormat{}))
+}
+
+// appendTemperature appends the temperature to b in °C, or in K when it cannot
+// be represented in °C, formatted as described by f.
+func appendTemperature(b []byte, t Temperature, f siFormat) []byte {
 	if t < -ZeroCelsius || t > maxCelsius {
-		return nanoAsString(int64(t)) + "K"
+		return append(appendSI(b, int64(t), nano, f), 'K')
+	}
+	return append(appendSI(b, int64(t-ZeroCelsius), nano, f), "°C"...)
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (t Temperature) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(t), "nK", func(b []byte, prec int) []byte {
+		return appendTemperature(b, t, siPrecision(prec))
+	})
+}
+
+// FormatUnit returns the temperature formatted in one of the units accepted by
+// Set, "K", "°C", "C", "°F", "F", "°R" or "R", with an optional SI prefix,
+// rounded to precision digits after the decimal point. A negative precision
+// uses as many digits as necessary to represent the temperature exactly.
+func (t Temperature) FormatUnit(symbol string, precision int) (string, error) {
+	switch hasSuffixes(symbol, temperatureUnits...) {
+	case "°C", "C":
+		return formatUnit(int64(t-ZeroCelsius), symbol, precision,
+			scaledUnit{"°C", int64(Celsius), 1},
+			scaledUnit{"C", int64(Celsius), 1},
+		)
+	case "°F", "F":
+		// Same conversion factor as Set.
+		return formatUnit(int64(t-ZeroFahrenheit), symbol, precision,
+			scaledUnit{"°F", 555555555556, 1000},
+			scaledUnit{"F", 555555555556, 1000},
+		)
+	case "°R", "R":
+		// Same conversion factor as Set.
+		return formatUnit(int64(t), symbol, precision,
+			scaledUnit{"°R", 555555555556, 1000},
+			scaledUnit{"R", 555555555556, 1000},
+		)
+	case "K":
+		return formatUnit(int64(t), symbol, precision, scaledUnit{"K", int64(Kelvin), 1})
+	default:
+		return "", incorrectUnitErr("K, °C, C, °F, F, °R or R")
 	}
-	return nanoAsString(int64(t-ZeroCelsius)) + "°C"
 }
 
 // Set sets the Temperature to the value represented by s. Units are to be
-// provided in "C", "°C", "F", "°F" or "K" with an optional SI prefix: "p", "n",
-// "u", "µ", "m", "k", "M", "G" or "T".
+// provided in "C", "°C", "F", "°F", "R", "°R" or "K" with an optional SI
+// prefix: "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T".
 func (t *Temperature) Set(s string) error {
 	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s[n:], "°C", "C", "°F", "F", "K"); found != "" {
+				if found := hasSuffixes(s[n:], temperatureUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("K, °C, C, °F or F")
+				return notNumberUnitErr("K, °C, C, °F, F, °R or R")
 			case errOverflowsInt64:
 				// TODO(maruel): Look for suffix, and reuse it.
 				return maxValueErr(maxTemperature.String())
@@ -58,7 +105,7 @@ func (t *Temperature) Set(s string) error {
 			return errors.New("unexpected end of string")
 		}
 		var siSize int
-		si, siSize = parseSIPrefix(r)
+		si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
 		n += siSize
 	}
 	switch s[n:] {
@@ -77,6 +124,9 @@ func (t *Temperature) Set(s string) error {
 			}
 			return maxValueErr(strconv.FormatInt(int64(maxFahrenheit), 10) + "F")
 		}
+		if roundsToZero(f, v) {
+			return resolutionErr("1nK")
+		}
 		// We need an extra check here to make sure that will not overflow with
 		// the addition of ZeroFahrenheit.
 		switch {
@@ -87,6 +137,28 @@ func (t *Temperature) Set(s string) error {
 		}
 		v += int64(ZeroFahrenheit)
 		*t = (Temperature)(v)
+	case "R", "°R":
+		// R to nK  nK = 555555555.556*R
+		rPerK := decimal{
+			base: 555555555556,
+			exp:  -3,
+			neg:  false,
+		}
+		r, _ := decimalMul(d, rPerK)
+		v, overflow := dtoi(r, int(si))
+		if overflow {
+			if r.neg {
+				return minValueErr("0R")
+			}
+			return maxValueErr(strconv.FormatInt(int64(maxRankine), 10) + "R")
+		}
+		if roundsToZero(r, v) {
+			return resolutionErr("1nK")
+		}
+		if v < 0 {
+			return minValueErr("0R")
+		}
+		*t = (Temperature)(v)
 	case "K":
 		v, overflow := dtoi(d, int(si-nano))
 		if overflow {
@@ -95,6 +167,9 @@ func (t *Temperature) Set(s string) error {
 			}
 			return maxValueErr(strconv.FormatInt(int64(maxTemperature/1000000000), 10) + "K")
 		}
+		if roundsToZero(d, v) {
+			return resolutionErr("1nK")
+		}
 		if v < 0 {
 			return minValueErr("0K")
 		}
@@ -107,6 +182,9 @@ func (t *Temperature) Set(s string) error {
 			}
 			return maxValueErr(strconv.FormatInt(int64(maxCelsius/1000000000), 10) + "°C")
 		}
+		if roundsToZero(d, v) {
+			return resolutionErr("1nK")
+		}
 		// We need an extra check here to make sure that will not overflow with
 		// the addition of ZeroCelsius.
 		switch {
@@ -118,16 +196,68 @@ func (t *Temperature) Set(s string) error {
 		v += int64(ZeroCelsius)
 		*t = (Temperature)(v)
 	case "":
-		return noUnitErr("K, °C, C, °F or F")
+		return noUnitErr("K, °C, C, °F, F, °R or R")
 	default:
-		if found := hasSuffixes(s[n:], "°C", "C", "°F", "F", "K"); found != "" {
-			return unknownUnitPrefixErr(found, "p,n,u,µ,m,k,M,G or T")
+		if found := hasSuffixes(s[n:], temperatureUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
 		}
-		return incorrectUnitErr("K, °C, C, °F or F")
+		return incorrectUnitErr("K, °C, C, °F, F, °R or R")
 	}
 	return nil
 }
 
+// temperatureUnits are the units accepted by Temperature.Set.
+var temperatureUnits = []string{"°C", "C", "°F", "F", "°R", "R", "K"}
+
+// MarshalJSON implements json.Marshaler. The Temperature is encoded as a JSON
+// string of the text appended by AppendText, which UnmarshalJSON parses back to
+// the same value.
+func (t Temperature) MarshalJSON() ([]byte, error) {
+	return marshalJSON(t.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano kelvins.
+func (t *Temperature) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, t.Set, func(v int64) error {
+		*t = Temperature(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Temperature to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (t Temperature) AppendText(b []byte) ([]byte, error) {
+	return appendTemperature(b, t, siFormat{mode: siExact}), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Temperature is encoded as
+// appended by AppendText.
+func (t Temperature) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (t *Temperature) UnmarshalText(text []byte) error {
+	return t.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Temperature is stored as an integer of
+// nano kelvins.
+func (t Temperature) Value() (driver.Value, error) {
+	return int64(t), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano kelvins or
+// text in a format understood by Set.
+func (t *Temperature) Scan(src any) error {
+	return scanValue(src, t.Set, func(v int64) error {
+		*t = Temperature(v)
+		return nil
+	})
+}
+
 // K returns the temperature as a floating number of °Kelvin.
 func (t Temperature) K() float64 {
 	return float64(t) / float64(Kelvin)
@@ -143,6 +273,11 @@ func (t Temperature) F() float64 {
 	return float64(t-ZeroFahrenheit) / float64(Fahrenheit)
 }
 
+// R returns the temperature as a floating number of °Rankine.
+func (t Temperature) R() float64 {
+	return float64(t) / float64(Kelvin) * 1.8
+}
+
 const (
 	NanoKelvin  Temperature = 1
 	MicroKelvin Temperature = 1000 * NanoKelvin
@@ -162,6 +297,10 @@ const (
 	MilliFahrenheit Temperature = 555555 * NanoKelvin
 	Fahrenheit      Temperature = 555555555 * NanoKelvin
 
+	// Conversion between Kelvin and Rankine.
+	MilliRankine Temperature = 555555 * NanoKelvin
+	Rankine      Temperature = 555555555 * NanoKelvin
+
 	maxTemperature Temperature = (1 << 63) - 1
 	minTemperature Temperature = 0
 
@@ -170,4 +309,7 @@ const (
 
 	// Maximum Fahrenheit is 16602069204F
 	maxFahrenheit Temperature = 16602069204
+
+	// Maximum Rankine is 16602069664R
+	maxRankine Temperature = 16602069664
 )
@@ -0,0 +1,227 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// TemperatureDifference is an interval between two temperatures stored as an
+// int64 nano kelvin.
+//
+// Unlike Temperature it is relative, so "3°C" is a difference of 3 kelvin and
+// not an absolute temperature of 276.15K. Negative values are valid.
+//
+// The highest representable value is 9.2GK.
+type TemperatureDifference int64
+
+// String returns the temperature difference formatted as a string in °Celsius.
+func (d TemperatureDifference) String() string {
+	return formatSI(int64(d), nano, siFormat{}) + "°C"
+}
+
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (d TemperatureDifference) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(d), "nK", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(d), nano, siPrecision(prec)), "°C"...)
+	})
+}
+
+// FormatUnit returns the temperature difference formatted in one of the units
+// accepted by Set, "K", "°C", "C", "°F", "F", "°R" or "R", with an optional SI
+// prefix, rounded to precision digits after the decimal point. A negative
+// precision uses as many digits as necessary to represent the difference
+// exactly.
+func (d TemperatureDifference) FormatUnit(symbol string, precision int) (string, error) {
+	return formatUnit(int64(d), symbol, precision,
+		scaledUnit{"°C", int64(Celsius), 1},
+		scaledUnit{"C", int64(Celsius), 1},
+		// Same conversion factor as Set.
+		scaledUnit{"°F", 555555555556, 1000},
+		scaledUnit{"F", 555555555556, 1000},
+		scaledUnit{"°R", 555555555556, 1000},
+		scaledUnit{"R", 555555555556, 1000},
+		scaledUnit{"K", int64(Kelvin), 1},
+	)
+}
+
+// Set sets the TemperatureDifference to the value represented by s. Units are
+// to be provided in "C", "°C", "F", "°F", "R", "°R" or "K" with an optional SI
+// prefix: "p", "n", "u", "µ", "m", "c", "d", "da", "h", "k", "M", "G" or "T".
+// The value is an interval, so no offset is applied: "9°F" is the same
+// difference as "5°C".
+func (d *TemperatureDifference) Set(s string) error {
+	dec, n, err := atod(s)
+	if err != nil {
+		if e, ok := err.(*parseError); ok {
+			switch e.error {
+			case errNotANumber:
+				if found := hasSuffixes(s[n:], temperatureUnits...); found != "" {
+					return err
+				}
+				return notNumberUnitErr("K, °C, C, °F, F, °R or R")
+			case errOverflowsInt64:
+				// TODO(maruel): Look for suffix, and reuse it.
+				return maxValueErr(maxTemperatureDifference.String())
+			case errOverflowsInt64Negative:
+				// TODO(maruel): Look for suffix, and reuse it.
+				return minValueErr(minTemperatureDifference.String())
+			}
+		}
+		return err
+	}
+
+	var si prefix
+	if n != len(s) {
+		r, rsize := utf8.DecodeRuneInString(s[n:])
+		if r <= 1 || rsize == 0 {
+			return errors.New("unexpected end of string")
+		}
+		var siSize int
+		si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+		n += siSize
+	}
+	switch s[n:] {
+	case "F", "°F", "R", "°R":
+		// F or R to nK  nK = 555555555.556*F
+		fPerK := decimal{
+			base: 555555555556,
+			exp:  -3,
+			neg:  false,
+		}
+		f, _ := decimalMul(dec, fPerK)
+		v, overflow := dtoi(f, int(si))
+		if overflow {
+			if f.neg {
+				return minValueErr("-" + strconv.FormatInt(int64(maxRankine), 10) + s[n:])
+			}
+			return maxValueErr(strconv.FormatInt(int64(maxRankine), 10) + s[n:])
+		}
+		if roundsToZero(f, v) {
+			return resolutionErr(TemperatureDifference(NanoKelvin).String())
+		}
+		*d = (TemperatureDifference)(v)
+	case "K", "C", "°C":
+		v, overflow := dtoi(dec, int(si-nano))
+		if overflow {
+			if dec.neg {
+				return minValueErr(minTemperatureDifference.String())
+			}
+			return maxValueErr(maxTemperatureDifference.String())
+		}
+		if roundsToZero(dec, v) {
+			return resolutionErr(TemperatureDifference(NanoKelvin).String())
+		}
+		*d = (TemperatureDifference)(v)
+	case "":
+		return noUnitErr("K, °C, C, °F, F, °R or R")
+	default:
+		if found := hasSuffixes(s[n:], temperatureUnits...); found != "" {
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		return incorrectUnitErr("K, °C, C, °F, F, °R or R")
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The TemperatureDifference is encoded
+// as a JSON string of the text appended by AppendText, which UnmarshalJSON
+// parses back to the same value.
+func (d TemperatureDifference) MarshalJSON() ([]byte, error) {
+	return marshalJSON(d.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano kelvins.
+func (d *TemperatureDifference) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, d.Set, func(v int64) error {
+		*d = TemperatureDifference(v)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the
+// TemperatureDifference to b exactly, without the rounding of String, so that
+// Set parses the text back to the same value. It does not allocate when b has
+// enough capacity.
+func (d TemperatureDifference) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(d), nano, siFormat{mode: siExact}), "°C"...), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The TemperatureDifference is
+// encoded as appended by AppendText.
+func (d TemperatureDifference) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (d *TemperatureDifference) UnmarshalText(text []byte) error {
+	return d.Set(string(text))
+}
+
+// Value implements driver.Valuer. The TemperatureDifference is stored as an
+// integer of nano kelvins.
+func (d TemperatureDifference) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano kelvins or
+// text in a format understood by Set.
+func (d *TemperatureDifference) Scan(src any) error {
+	return scanValue(src, d.Set, func(v int64) error {
+		*d = TemperatureDifference(v)
+		return nil
+	})
+}
+
+// K returns the temperature difference as a floating number of kelvins.
+func (d TemperatureDifference) K() float64 {
+	return float64(d) / float64(Kelvin)
+}
+
+// C returns the temperature difference as a floating number of °Celsius.
+func (d TemperatureDifference) C() float64 {
+	return float64(d) / float64(Celsius)
+}
+
+// F returns the temperature difference as a floating number of °Fahrenheit.
+func (d TemperatureDifference) F() float64 {
+	return float64(d) / float64(Kelvin) * 1.8
+}
+
+// R returns the temperature difference as a floating number of °Rankine.
+func (d TemperatureDifference) R() float64 {
+	return float64(d) / float64(Kelvin) * 1.8
+}
+
+// Sub returns the difference t-u.
+func (t Temperature) Sub(u Temperature) TemperatureDifference {
+	return TemperatureDifference(t - u)
+}
+
+// Add returns the temperature t+d. It can be used to apply a calibration
+// offset to a measurement. The result saturates at 0K and at the highest
+// representable Temperature.
+func (t Temperature) Add(d TemperatureDifference) Temperature {
+	switch {
+	case d > 0 && t > maxTemperature-Temperature(d):
+		return maxTemperature
+	case d < 0 && t+Temperature(d) < minTemperature:
+		return minTemperature
+	}
+	return t + Temperature(d)
+}
+
+const (
+	maxTemperatureDifference TemperatureDifference = (1 << 63) - 1
+	minTemperatureDifference TemperatureDifference = -maxTemperatureDifference
+)
@@ -0,0 +1,201 @@
+// Copyright 2018 The Periph Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Modifications 2024 Sam Anthony.
+
+package unit
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTemperatureDifference_String(t *testing.T) {
+	if s := TemperatureDifference(3 * Kelvin).String(); s != "3°C" {
+		t.Fatalf("%#v", s)
+	}
+	if s := TemperatureDifference(-1500 * MilliKelvin).String(); s != "-1.500°C" {
+		t.Fatalf("%#v", s)
+	}
+}
+
+func TestTemperatureDifference_Set(t *testing.T) {
+	succeeds := []struct {
+		in       string
+		expected TemperatureDifference
+	}{
+		{"0K", 0},
+		{"3K", 3000000000},
+		{"3C", 3000000000},
+		{"3°C", 3000000000},
+		{"-3°C", -3000000000},
+		{"5.4F", 3000000000},
+		{"5.4°F", 3000000000},
+		{"-5.4°F", -3000000000},
+		{"9°F", 5000000000},
+		{"1R", 555555556},
+		{"1.8°R", 1000000000},
+		{"1mK", 1000000},
+		{"1kC", 1000000000000},
+		{"1GK", 1000000000000000000},
+		{fmt.Sprintf("%dnK", int64(maxTemperatureDifference)), maxTemperatureDifference},
+		{fmt.Sprintf("%dnK", int64(minTemperatureDifference)), minTemperatureDifference},
+		{fmt.Sprintf("%dF", int64(maxRankine)), 9223372034071203096},
+		{fmt.Sprintf("-%dF", int64(maxRankine)), -9223372034071203096},
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{
+			"10TK",
+			"maximum value is 9.223G°C",
+		},
+		{
+			"-10TC",
+			"minimum value is -9.223G°C",
+		},
+		{
+			"9223372036854775808nK",
+			"maximum value is 9.223G°C",
+		},
+		{
+			"-9223372036854775808nK",
+			"minimum value is -9.223G°C",
+		},
+		{
+			fmt.Sprintf("%dF", int64(maxRankine+1)),
+			"maximum value is 16602069664F",
+		},
+		{
+			fmt.Sprintf("-%d°F", int64(maxRankine+1)),
+			"minimum value is -16602069664°F",
+		},
+		{
+			"10E°C",
+			"unknown unit prefix; valid prefixes for \"°C\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"10",
+			"no unit provided; need K, °C, C, °F, F, °R or R",
+		},
+		{
+			"1random",
+			"unknown unit provided; need K, °C, C, °F, F, °R or R",
+		},
+		{
+			"K",
+			"not a number",
+		},
+		{
+			"°F",
+			"not a number",
+		},
+		{
+			"RPM",
+			"does not contain number or unit K, °C, C, °F, F, °R or R",
+		},
+		{
+			"++1°C",
+			"contains multiple plus symbols",
+		},
+		{
+			"1.1.1.1°C",
+			"contains multiple decimal points",
+		},
+		{
+			string([]byte{0x33, 0x01}),
+			"unexpected end of string",
+		},
+	}
+
+	for i, tt := range succeeds {
+		var got TemperatureDifference
+		if err := got.Set(tt.in); err != nil {
+			t.Errorf("#%d: TemperatureDifference.Set(%s) unexpected error: %v", i, tt.in, err)
+		}
+		if got != tt.expected {
+			t.Errorf("#%d: TemperatureDifference.Set(%s) wanted: %v(%d) but got: %v(%d)", i, tt.in, tt.expected, tt.expected, got, got)
+		}
+	}
+
+	for i, tt := range fails {
+		var got TemperatureDifference
+		if err := got.Set(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: TemperatureDifference.Set(%s) \nexpected: %s\ngot:      %s", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestTemperatureDifference_RoundTrip(t *testing.T) {
+	x := TemperatureDifference(-123 * MilliKelvin)
+	var y TemperatureDifference
+	if err := y.Set(x.String()); err != nil {
+		t.Fatalf("TemperatureDifference.Set(stringer) failed: %v", err)
+	}
+	if x != y {
+		t.Fatalf("TemperatureDifference expected %s to equal %s", x, y)
+	}
+}
+
+func TestTemperatureDifference_K(t *testing.T) {
+	if v := TemperatureDifference(-123 * Kelvin).K(); v != -123. {
+		t.Fatal(v)
+	}
+}
+
+func TestTemperatureDifference_C(t *testing.T) {
+	if v := TemperatureDifference(123 * Celsius).C(); v != 123. {
+		t.Fatal(v)
+	}
+}
+
+func TestTemperatureDifference_F(t *testing.T) {
+	if v := TemperatureDifference(5 * Kelvin).F(); v != 9. {
+		t.Fatal(v)
+	}
+}
+
+func TestTemperatureDifference_R(t *testing.T) {
+	if v := TemperatureDifference(-5 * Kelvin).R(); v != -9. {
+		t.Fatal(v)
+	}
+}
+
+func TestTemperature_Sub(t *testing.T) {
+	data := []struct {
+		t, u     Temperature
+		expected TemperatureDifference
+	}{
+		{ZeroCelsius + 23*Celsius, ZeroCelsius + 20*Celsius, 3000000000},
+		{ZeroCelsius, ZeroFahrenheit, 17777777778},
+		{0, maxTemperature, -maxTemperatureDifference},
+	}
+	for i, line := range data {
+		if v := line.t.Sub(line.u); v != line.expected {
+			t.Fatalf("%d: %s.Sub(%s) = %s != %s", i, line.t, line.u, v, line.expected)
+		}
+	}
+}
+
+func TestTemperature_Add(t *testing.T) {
+	data := []struct {
+		t        Temperature
+		d        TemperatureDifference
+		expected Temperature
+	}{
+		{ZeroCelsius + 20*Celsius, 3000000000, ZeroCelsius + 23*Celsius},
+		{ZeroCelsius, -3000000000, ZeroCelsius - 3*Celsius},
+		{ZeroCelsius, 0, ZeroCelsius},
+		{Kelvin, -2000000000, 0},
+		{maxTemperature - Kelvin, 2000000000, maxTemperature},
+		{ZeroCelsius, minTemperatureDifference - 1, 0},
+	}
+	for i, line := range data {
+		if v := line.t.Add(line.d); v != line.expected {
+			t.Fatalf("%d: %s.Add(%s) = %s != %s", i, line.t, line.d, v, line.expected)
+		}
+	}
+}
@@ -34,6 +34,13 @@ func TestTemperature_Set(t *testing.T) {
 		{"1GK", GigaKelvin},
 		{"1kC", ZeroCelsius + 1000*Celsius},
 		{"16kF", 9144261111118},
+		{"0R", 0},
+		{"0°R", 0},
+		{"491.67R", ZeroCelsius},
+		{"1R", 555555556},
+		{"1mR", 555556},
+		{"1k°R", 555555555556},
+		{fmt.Sprintf("%dR", int64(maxRankine)), 9223372034071203096},
 	}
 
 	fails := []struct {
@@ -96,17 +103,37 @@ func TestTemperature_Set(t *testing.T) {
 			"-9.224TF",
 			"minimum value is -459.67F",
 		},
+		{
+			fmt.Sprintf("%dR", int64(maxRankine+1)),
+			"maximum value is 16602069664R",
+		},
+		{
+			"-1R",
+			"minimum value is 0R",
+		},
+		{
+			"-9.224TR",
+			"minimum value is 0R",
+		},
+		{
+			"10E°R",
+			"unknown unit prefix; valid prefixes for \"°R\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
+		},
+		{
+			"°R",
+			"not a number",
+		},
 		{
 			"10E°C",
-			"unknown unit prefix; valid prefixes for \"°C\" are p,n,u,µ,m,k,M,G or T",
+			"unknown unit prefix; valid prefixes for \"°C\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10",
-			"no unit provided; need K, °C, C, °F or F",
+			"no unit provided; need K, °C, C, °F, F, °R or R",
 		},
 		{
 			"1random",
-			"unknown unit provided; need K, °C, C, °F or F",
+			"unknown unit provided; need K, °C, C, °F, F, °R or R",
 		},
 		{
 			"C",
@@ -126,7 +153,7 @@ func TestTemperature_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit K, °C, C, °F or F",
+			"does not contain number or unit K, °C, C, °F, F, °R or R",
 		},
 		{
 			"++1°C",
@@ -205,3 +232,12 @@ func TestTemperature_F(t *testing.T) {
 		t.Fatal(v)
 	}
 }
+
+func TestTemperature_R(t *testing.T) {
+	if v := Temperature(0).R(); v != 0. {
+		t.Fatal(v)
+	}
+	if v := Temperature(5 * Kelvin).R(); v != 9. {
+		t.Fatal(v)
+	}
+}
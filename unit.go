@@ -7,255 +7,346 @@
 package unit
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode/utf8"
 )
 
-func prefixZeros(digits, v int) string {
+// appendPrefixZeros appends v to b, left padded with zeros to be at least
+// digits long.
+func appendPrefixZeros(b []byte, digits, v int) []byte {
 	// digits is expected to be around 2~3.
-	s := strconv.Itoa(v)
-	for len(s) < digits {
-		// O(n²) but since digits is expected to run 2~3 times at most, it doesn't
-		// matter.
-		s = "0" + s
+	n := 1
+	for x := v; x >= 10; x /= 10 {
+		n++
 	}
-	return s
+	for ; n < digits; n++ {
+		b = append(b, '0')
+	}
+	return strconv.AppendInt(b, int64(v), 10)
 }
 
-// nanoAsString converts a value in S.I. unit in a string with the predefined
-// prefix.
-func nanoAsString(v int64) string {
-	sign := ""
-	if v < 0 {
-		if v == -9223372036854775808 {
-			v++
+// appendDecimal appends u×10⁻ˢʰⁱᶠᵗ to b rounded to prec digits after the
+// decimal point. A negative prec appends as many digits as necessary to
+// represent the value exactly.
+func appendDecimal(b []byte, u uint64, shift, prec int) []byte {
+	if prec < 0 {
+		prec = shift
+		if prec < 0 {
+			prec = 0
+		}
+		// Trim the trailing zeros that do not need to be represented.
+		for prec > 0 && u%10 == 0 {
+			u /= 10
+			shift--
+			prec--
 		}
-		sign = "-"
-		v = -v
 	}
-	var frac int
-	var base int
-	var precision int64
-	unit := ""
-	switch {
-	case v >= 999999500000000001:
-		precision = v % 1000000000000000
-		base = int(v / 1000000000000000)
-		if precision > 500000000000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "G"
-	case v >= 999999500000001:
-		precision = v % 1000000000000
-		base = int(v / 1000000000000)
-		if precision > 500000000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "M"
-	case v >= 999999500001:
-		precision = v % 1000000000
-		base = int(v / 1000000000)
-		if precision > 500000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "k"
-	case v >= 999999501:
-		precision = v % 1000000
-		base = int(v / 1000000)
-		if precision > 500000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = ""
-	case v >= 1000000:
-		precision = v % 1000
-		base = int(v / 1000)
-		if precision > 500 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "m"
-	case v >= 1000:
-		frac = int(v) % 1000
-		base = int(v) / 1000
-		unit = "µ"
-	default:
-		if v == 0 {
-			return "0"
+	if d := shift - prec; d > 0 {
+		switch {
+		case d < len(powerOf10):
+			u = (u + powerOf10[d]/2) / powerOf10[d]
+		case d == len(powerOf10) && u >= 5*powerOf10[d-1]:
+			// 10¹⁹ does not fit in powerOf10 but u can still round up to 1.
+			u = 1
+		default:
+			u = 0
 		}
-		base = int(v)
-		unit = "n"
+		shift = prec
+	}
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], u, 10)
+	if shift < 0 {
+		// The value is an integer larger than u.
+		b = append(b, digits...)
+		for ; shift < 0; shift++ {
+			b = append(b, '0')
+		}
+		digits = digits[:0]
+	} else if len(digits) > shift {
+		b = append(b, digits[:len(digits)-shift]...)
+		digits = digits[len(digits)-shift:]
+	} else {
+		b = append(b, '0')
 	}
-	if frac == 0 {
-		return sign + strconv.Itoa(base) + unit
+	if prec == 0 {
+		return b
+	}
+	b = append(b, '.')
+	for i := len(digits); i < shift; i++ {
+		b = append(b, '0')
+	}
+	b = append(b, digits...)
+	for ; shift < prec; shift++ {
+		b = append(b, '0')
+	}
+	return b
+}
+
+// siMode selects how appendSI rounds a value.
+type siMode int
+
+const (
+	// siDefault is the format used by String: three digits after the decimal
+	// point, omitted when they are all zeros. Halves are rounded down.
+	siDefault siMode = iota
+	// siFixed rounds to a fixed number of digits after the decimal point.
+	siFixed
+	// siExact does not round: it uses as many digits after the decimal point as
+	// necessary to represent the value exactly. It is the format of
+	// AppendText, so that the text parses back to the same value.
+	siExact
+)
+
+// siFormat describes how appendSI formats a value.
+type siFormat struct {
+	mode siMode
+	// digits is the number of digits after the decimal point for siFixed.
+	digits int
+}
+
+// siPrecision returns the format used by Format for the precision prec, which
+// is negative when none was specified.
+func siPrecision(prec int) siFormat {
+	if prec < 0 {
+		return siFormat{}
 	}
-	return sign + strconv.Itoa(base) + "." + prefixZeros(3, frac) + unit
+	return siFormat{mode: siFixed, digits: prec}
+}
+
+// formatSI returns the value v in 10^base units formatted by appendSI.
+func formatSI(v int64, base prefix, f siFormat) string {
+	var buf [32]byte
+	return string(appendSI(buf[:0], v, base, f))
 }
 
-// microAsString converts a value in S.I. unit in a string with the predefined
-// prefix.
-func microAsString(v int64) string {
-	sign := ""
+// appendSI appends the value v in 10^base units to b with the SI prefix that
+// keeps at most 3 integer digits once rounded as described by f, up to 6
+// prefixes above the base unit. It does not allocate when b has enough
+// capacity.
+func appendSI(b []byte, v int64, base prefix, f siFormat) []byte {
+	if v == 0 {
+		// Zero has no magnitude to choose a prefix from, so it is formatted in
+		// the unit itself.
+		if f.mode == siFixed {
+			return appendDecimal(b, 0, 0, f.digits)
+		}
+		return append(b, '0')
+	}
+	u := uint64(v)
 	if v < 0 {
-		if v == -9223372036854775808 {
-			v++
+		b = append(b, '-')
+		u = -u
+	}
+	var si prefix
+	switch f.mode {
+	case siFixed:
+		si = base
+		for ; si < base+18; si += 3 {
+			shift := int(si - base)
+			if decimalDigits(roundShift(u, shift, f.digits))-min(shift, f.digits) <= 3 {
+				break
+			}
+		}
+		b = appendDecimal(b, u, int(si-base), f.digits)
+	case siExact:
+		shift := 0
+		for shift < 18 && u >= 1000*powerOf10[shift] {
+			shift += 3
+		}
+		si = base + prefix(shift)
+		b = appendDecimal(b, u, shift, -1)
+	default:
+		q, shift := roundThousandths(u)
+		si = base + prefix(shift)
+		b = strconv.AppendUint(b, q/1000, 10)
+		if frac := int(q % 1000); frac != 0 {
+			b = append(b, '.')
+			b = appendPrefixZeros(b, 3, frac)
+		}
+	}
+	return append(b, prefixSymbol(si)...)
+}
+
+// roundThousandths returns u×10⁻ˢʰⁱᶠᵗ in thousandths for the smallest shift,
+// a multiple of 3, that keeps it below 1000. Halves are rounded down.
+func roundThousandths(u uint64) (q uint64, shift int) {
+	if u < 1000 {
+		return u * 1000, 0
+	}
+	for shift = 3; ; shift += 3 {
+		d := powerOf10[shift-3]
+		q = u / d
+		if u%d > d/2 {
+			q++
+		}
+		if q < 1000000 || shift == 18 {
+			return q, shift
 		}
-		sign = "-"
-		v = -v
 	}
-	var frac int
-	var base int
-	var precision int64
-	unit := ""
+}
+
+// roundShift returns u with the digits beyond prec digits after the decimal
+// point of u×10⁻ˢʰⁱᶠᵗ rounded off.
+func roundShift(u uint64, shift, prec int) uint64 {
+	d := shift - prec
 	switch {
-	case v >= 999999500000000001:
-		precision = v % 1000000000000000
-		base = int(v / 1000000000000000)
-		if precision > 500000000000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "T"
-	case v >= 999999500000001:
-		precision = v % 1000000000000
-		base = int(v / 1000000000000)
-		if precision > 500000000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "G"
-	case v >= 999999500001:
-		precision = v % 1000000000
-		base = int(v / 1000000000)
-		if precision > 500000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "M"
-	case v >= 999999501:
-		precision = v % 1000000
-		base = int(v / 1000000)
-		if precision > 500000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "k"
-	case v >= 1000000:
-		precision = v % 1000
-		base = int(v / 1000)
-		if precision > 500 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = ""
-	case v >= 1000:
-		frac = int(v) % 1000
-		base = int(v) / 1000
-		unit = "m"
+	case d <= 0:
+		return u
+	case d < len(powerOf10):
+		return (u + powerOf10[d]/2) / powerOf10[d]
 	default:
-		if v == 0 {
-			return "0"
-		}
-		base = int(v)
-		unit = "µ"
+		return 0
 	}
-	if frac == 0 {
-		return sign + strconv.Itoa(base) + unit
+}
+
+// decimalDigits returns the number of decimal digits in u.
+func decimalDigits(u uint64) int {
+	n := 1
+	for ; u >= 10; u /= 10 {
+		n++
 	}
-	return sign + strconv.Itoa(base) + "." + prefixZeros(3, frac) + unit
+	return n
 }
 
-// picoAsString converts a value in S.I. unit in a string with the predefined
-// prefix.
-func picoAsString(v int64) string {
-	sign := ""
+// appendUnit appends the value v in 10^base units to b expressed in 10^target
+// units, rounded to prec digits after the decimal point.
+func appendUnit(b []byte, v int64, base, target prefix, prec int) []byte {
+	u := uint64(v)
 	if v < 0 {
-		if v == -9223372036854775808 {
-			v++
+		b = append(b, '-')
+		u = -u
+	}
+	return appendDecimal(b, u, int(target-base), prec)
+}
+
+// scaledUnit is a unit worth num/den raw values of a quantity.
+type scaledUnit struct {
+	symbol string
+	num    int64
+	den    int64
+}
+
+// formatUnit formats the raw value v of a quantity in the unit symbol, which
+// must be the symbol of one of units with an optional SI prefix.
+//
+// The value is rounded to prec digits after the decimal point. A negative prec
+// uses as many digits as necessary to represent the value exactly, or the
+// shortest representation that rounds to it when its decimal expansion does
+// not terminate.
+func formatUnit(v int64, symbol string, prec int, units ...scaledUnit) (string, error) {
+	// The unit is the longest symbol that symbol ends with, so that an exact
+	// match wins and, for example, "slug" is not read as "g" with the prefix
+	// "slu".
+	var u scaledUnit
+	for _, x := range units {
+		if len(x.symbol) > len(u.symbol) && strings.HasSuffix(symbol, x.symbol) {
+			u = x
 		}
-		sign = "-"
-		v = -v
 	}
-	var frac int
-	var base int
-	var precision int64
-	unit := ""
-	switch {
-	case v >= 999999500000000001:
-		precision = v % 1000000000000000
-		base = int(v / 1000000000000000)
-		if precision > 500000000000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "M"
-	case v >= 999999500000001:
-		precision = v % 1000000000000
-		base = int(v / 1000000000000)
-		if precision > 500000000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "k"
-	case v >= 999999500001:
-		precision = v % 1000000000
-		base = int(v / 1000000000)
-		if precision > 500000000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = ""
-	case v >= 999999501:
-		precision = v % 1000000
-		base = int(v / 1000000)
-		if precision > 500000 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "m"
-	case v >= 1000000:
-		precision = v % 1000
-		base = int(v / 1000)
-		if precision > 500 {
-			base++
-		}
-		frac = (base % 1000)
-		base = base / 1000
-		unit = "µ"
-	case v >= 1000:
-		frac = int(v) % 1000
-		base = int(v) / 1000
-		unit = "n"
-	default:
-		if v == 0 {
-			return "0"
+	if u.symbol == "" {
+		valid := units[len(units)-1].symbol
+		for i := len(units) - 2; i >= 0; i-- {
+			sep := ", "
+			if i == len(units)-2 {
+				sep = " or "
+			}
+			valid = units[i].symbol + sep + valid
 		}
-		base = int(v)
-		unit = "p"
+		return "", incorrectUnitErr(valid)
+	}
+	si := prefix(unit)
+	if p := symbol[:len(symbol)-len(u.symbol)]; p != "" {
+		var size int
+		si, size = parseSIPrefix(p, allPrefixes)
+		if si == unit || size != len(p) {
+			return "", unknownUnitPrefixErr(u.symbol, allPrefixes)
+		}
+	}
+	// One prefixed unit is num×10^si/den raw values.
+	num := big.NewInt(u.num)
+	den := big.NewInt(u.den)
+	if si > 0 {
+		num.Mul(num, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(si)), nil))
+	} else if si < 0 {
+		den.Mul(den, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-si)), nil))
+	}
+	r := new(big.Rat).SetFrac(den.Mul(den, big.NewInt(v)), num)
+	return formatRat(r, prec) + symbol, nil
+}
+
+// formatRat formats r rounded to prec digits after the decimal point. A
+// negative prec is handled as described in formatUnit.
+func formatRat(r *big.Rat, prec int) string {
+	if prec < 0 {
+		// The decimal expansion terminates only if the denominator has no
+		// prime factors other than 2 and 5.
+		d := new(big.Int).Set(r.Denom())
+		twos := int(d.TrailingZeroBits())
+		d.Rsh(d, uint(twos))
+		fives := 0
+		five := big.NewInt(5)
+		for m := new(big.Int); ; fives++ {
+			q, m := new(big.Int).QuoRem(d, five, m)
+			if m.Sign() != 0 {
+				break
+			}
+			d = q
+		}
+		if !d.IsInt64() || d.Int64() != 1 {
+			f, _ := r.Float64()
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		prec = max(twos, fives)
+	}
+	return r.FloatString(prec)
+}
+
+// formatQuantity implements fmt.Formatter for a quantity whose raw value is
+// raw in rawUnit.
+//
+// The verbs 'v' and 's' format the quantity as appended by appendPrec, which
+// receives the precision of the verb or -1 when none is specified. The '+' flag
+// appends the raw value and the width pads the result with spaces, on the
+// right with the '-' flag. The verb 'q' quotes the result. Any other verb, and
+// %#v, formats the raw value as an int64.
+func formatQuantity(f fmt.State, verb rune, raw int64, rawUnit string, appendPrec func(b []byte, prec int) []byte) {
+	if (verb != 'v' && verb != 's' && verb != 'q') || (verb == 'v' && f.Flag('#')) {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), raw)
+		return
+	}
+	prec, ok := f.Precision()
+	if !ok {
+		prec = -1
+	}
+	var buf [64]byte
+	b := appendPrec(buf[:0], prec)
+	if f.Flag('+') {
+		b = append(b, " ("...)
+		b = strconv.AppendInt(b, raw, 10)
+		b = append(b, rawUnit...)
+		b = append(b, ')')
 	}
-	if frac == 0 {
-		return sign + strconv.Itoa(base) + unit
+	if verb == 'q' {
+		b = strconv.AppendQuote(nil, string(b))
 	}
-	return sign + strconv.Itoa(base) + "." + prefixZeros(3, frac) + unit
+	if w, ok := f.Width(); ok {
+		if n := utf8.RuneCount(b); n < w {
+			pad := strings.Repeat(" ", w-n)
+			if f.Flag('-') {
+				b = append(b, pad...)
+			} else {
+				b = append([]byte(pad), b...)
+			}
+		}
+	}
+	f.Write(b)
 }
 
 // Decimal is the representation of decimal number.
@@ -300,6 +391,7 @@ var (
 	errOverflowsInt64         = errors.New("exceeds maximum")
 	errOverflowsInt64Negative = errors.New("exceeds minimum")
 	errNotANumber             = errors.New("not a number")
+	errRoundsToZero           = errors.New("rounds to zero")
 )
 
 // Converts from decimal to int64.
@@ -345,6 +437,31 @@ func dtoi(d decimal, scale int) (int64, bool) {
 	return n, false
 }
 
+// mulDiv returns v×num/den rounded half away from zero, using a 128 bit
+// intermediate product. num and den must be positive. The bool is true if the
+// result overflows int64.
+func mulDiv(v, num, den int64) (int64, bool) {
+	neg := v < 0
+	if neg {
+		v = -v
+	}
+	hi, lo := bits.Mul64(uint64(v), uint64(num))
+	if hi >= uint64(den) {
+		return 0, true
+	}
+	q, r := bits.Div64(hi, lo, uint64(den))
+	if r >= uint64(den)-r {
+		q++
+	}
+	if q > maxInt64 {
+		return 0, true
+	}
+	if neg {
+		return -int64(q), false
+	}
+	return int64(q), false
+}
+
 // Converts a string to a decimal form. The return int is how many bytes of the
 // string are considered numeric. The string may contain +-0 prefixes and
 // arbitrary suffixes as trailing non number characters are ignored.
@@ -491,24 +608,27 @@ func atod(s string) (decimal, int, error) {
 }
 
 // valueOfUnitString is a helper for converting a string and a prefix in to a
-// physic unit. It can be used when characters of the units do not conflict with
-// any of the SI prefixes.
-func valueOfUnitString(s string, base prefix) (int64, int, error) {
+// physic unit. Only the SI prefixes in the range r are parsed, and none is
+// parsed when the rest of s is one of units, so a unit may start with the
+// symbol of a prefix.
+func valueOfUnitString(s string, base prefix, r prefixRange, units ...string) (int64, int, error) {
 	d, n, err := atod(s)
 	if err != nil {
 		return 0, n, err
 	}
 	si := prefix(unit)
 	if n != len(s) {
-		r, rsize := utf8.DecodeRuneInString(s[n:])
-		if r <= 1 || rsize == 0 {
+		c, size := utf8.DecodeRuneInString(s[n:])
+		if c <= 1 || size == 0 {
 			return 0, 0, &parseError{
 				errors.New("unexpected end of string"),
 			}
 		}
-		var siSize int
-		si, siSize = parseSIPrefix(r)
-		n += siSize
+		if !slices.Contains(units, s[n:]) {
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], r)
+			n += siSize
+		}
 	}
 	v, overflow := dtoi(d, int(si-base))
 	if overflow {
@@ -517,6 +637,9 @@ func valueOfUnitString(s string, base prefix) (int64, int, error) {
 		}
 		return maxInt64, 0, &parseError{errOverflowsInt64}
 	}
+	if roundsToZero(d, v) {
+		return 0, 0, &parseError{errRoundsToZero}
+	}
 	return v, n, nil
 }
 
@@ -574,6 +697,32 @@ func decimalMul(a, b decimal) (decimal, uint) {
 	return decimal{}, 21
 }
 
+// dtoiScaled converts d, in SI prefixed si units each worth factor raw values,
+// to a raw value.
+//
+// Returns lossy true if decimalMul had to truncate more than 9 significant
+// figures, or overflow true if the value overflowed.
+func dtoiScaled(d, factor decimal, si prefix) (v int64, lossy, overflow bool) {
+	x, loss := decimalMul(d, factor)
+	if loss > 9 {
+		return 0, true, false
+	}
+	// Factors smaller than one may scale x below what dtoi can represent. The
+	// base is less than maxInt64 here so anything below 10^-19 rounds to zero.
+	switch exp := x.exp + int(si); {
+	case exp < -19:
+		return 0, false, false
+	case exp == -19:
+		u := (x.base + 5*powerOf10[18]) / (10 * powerOf10[18])
+		if x.neg {
+			return -int64(u), false, false
+		}
+		return int64(u), false, false
+	}
+	v, overflow = dtoi(x, int(si))
+	return v, false, overflow
+}
+
 // hasSuffixes returns the first suffix found and the prefix content.
 func hasSuffixes(s string, suffixes ...string) string {
 	for _, suffix := range suffixes {
@@ -596,8 +745,8 @@ func incorrectUnitErr(valid string) error {
 	return errors.New("unknown unit provided; need " + valid)
 }
 
-func unknownUnitPrefixErr(unit, valid string) error {
-	return errors.New("unknown unit prefix; valid prefixes for \"" + unit + "\" are " + valid)
+func unknownUnitPrefixErr(unit string, valid prefixRange) error {
+	return errors.New("unknown unit prefix; valid prefixes for \"" + unit + "\" are " + valid.String())
 }
 
 func maxValueErr(valid string) error {
@@ -608,17 +757,92 @@ func minValueErr(valid string) error {
 	return errors.New("minimum value is " + valid)
 }
 
+// roundsToZero reports whether the nonzero decimal d was rounded to v == 0
+// because it is smaller than the resolution of the quantity.
+func roundsToZero(d decimal, v int64) bool {
+	return v == 0 && d.base != 0
+}
+
+func resolutionErr(valid string) error {
+	return errors.New("nonzero value rounds to zero; resolution is " + valid)
+}
+
 func notNumberUnitErr(unit string) error {
 	return errors.New("does not contain number or unit " + unit)
 }
 
+// marshalJSON encodes the text of a quantity, as returned by its MarshalText
+// method, as a JSON string.
+func marshalJSON(text []byte, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// unmarshalJSON decodes a quantity from the JSON value b.
+//
+// A JSON string is passed to set, which is expected to be the Set method of the
+// quantity. A JSON number must be an integer and is passed to setRaw as the
+// value in the storage unit of the quantity, which is how the quantities were
+// encoded before they implemented json.Marshaler. The JSON null value is a
+// no-op.
+func unmarshalJSON(b []byte, set func(string) error, setRaw func(int64) error) error {
+	switch {
+	case string(b) == "null":
+		return nil
+	case len(b) != 0 && b[0] == '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		return set(s)
+	default:
+		v, err := strconv.ParseInt(string(b), 10, 64)
+		if err != nil {
+			if e, ok := err.(*strconv.NumError); ok && e.Err == strconv.ErrRange {
+				if len(b) != 0 && b[0] == '-' {
+					return errOverflowsInt64Negative
+				}
+				return errOverflowsInt64
+			}
+			return errors.New("expected a JSON string or integer, got " + string(b))
+		}
+		return setRaw(v)
+	}
+}
+
+// scanValue scans a quantity from the database value src.
+//
+// Text is passed to set, which is expected to be the Set method of the
+// quantity. An integer is passed to setRaw as the value in the storage unit of
+// the quantity.
+func scanValue(src any, set func(string) error, setRaw func(int64) error) error {
+	switch v := src.(type) {
+	case int64:
+		return setRaw(v)
+	case string:
+		return set(v)
+	case []byte:
+		return set(string(v))
+	case nil:
+		return errors.New("cannot scan NULL; use sql.Null")
+	default:
+		return errors.New("unsupported database type; need int64, string or []byte")
+	}
+}
+
 type prefix int
 
 const (
+	atto  prefix = -18
+	femto prefix = -15
 	pico  prefix = -12
 	nano  prefix = -9
 	micro prefix = -6
 	milli prefix = -3
+	centi prefix = -2
+	deci  prefix = -1
 	unit  prefix = 0
 	deca  prefix = 1
 	hecto prefix = 2
@@ -626,29 +850,112 @@ const (
 	mega  prefix = 6
 	giga  prefix = 9
 	tera  prefix = 12
+	peta  prefix = 15
+	exa   prefix = 18
 )
 
-func parseSIPrefix(r rune) (prefix, int) {
-	switch r {
-	case 'p':
-		return pico, len("p")
-	case 'n':
-		return nano, len("n")
-	case 'u':
-		return micro, len("u")
-	case 'µ':
-		return micro, len("µ")
-	case 'm':
-		return milli, len("m")
-	case 'k':
-		return kilo, len("k")
-	case 'M':
-		return mega, len("M")
-	case 'G':
-		return giga, len("G")
-	case 'T':
-		return tera, len("T")
-	default:
-		return unit, 0
+// siPrefixes are the symbols of the SI prefixes in increasing order. Micro has
+// both the ASCII "u" and the proper "µ".
+var siPrefixes = []struct {
+	symbol string
+	prefix prefix
+}{
+	{"a", atto},
+	{"f", femto},
+	{"p", pico},
+	{"n", nano},
+	{"u", micro},
+	{"µ", micro},
+	{"m", milli},
+	{"c", centi},
+	{"d", deci},
+	{"da", deca},
+	{"h", hecto},
+	{"k", kilo},
+	{"M", mega},
+	{"G", giga},
+	{"T", tera},
+	{"P", peta},
+	{"E", exa},
+}
+
+// prefixSymbol returns the symbol of the SI prefix p.
+func prefixSymbol(p prefix) string {
+	switch p {
+	case micro:
+		return "µ"
+	case unit:
+		return ""
+	}
+	for _, x := range siPrefixes {
+		if x.prefix == p {
+			return x.symbol
+		}
+	}
+	return ""
+}
+
+// prefixRange is the inclusive range of SI prefixes accepted by a Set method.
+//
+// A prefix is accepted when a value between 0.001 and 1000 of the prefixed
+// unit is representable, given the resolution and the range of the storage.
+type prefixRange struct {
+	lo, hi prefix
+}
+
+var (
+	// allPrefixes are accepted by FormatUnit, which is not limited by the
+	// storage.
+	allPrefixes = prefixRange{atto, exa}
+	// picoPrefixes are accepted by quantities stored as an int64 of pico units.
+	picoPrefixes = prefixRange{femto, giga}
+	// nanoPrefixes are accepted by quantities stored as an int64 of nano units.
+	nanoPrefixes = prefixRange{pico, tera}
+	// microPrefixes are accepted by quantities stored as an int64 of micro
+	// units.
+	microPrefixes = prefixRange{nano, peta}
+)
+
+// contains returns true if p is in the range.
+func (r prefixRange) contains(p prefix) bool {
+	return p >= r.lo && p <= r.hi
+}
+
+// String returns the symbols of the prefixes in the range, as in
+// "p,n,u,µ,m,c,d,da,h,k,M,G or T".
+func (r prefixRange) String() string {
+	var b []byte
+	var last string
+	for _, x := range siPrefixes {
+		if !r.contains(x.prefix) {
+			continue
+		}
+		if last != "" {
+			if len(b) != 0 {
+				b = append(b, ',')
+			}
+			b = append(b, last...)
+		}
+		last = x.symbol
+	}
+	if len(b) == 0 {
+		return last
+	}
+	return string(b) + " or " + last
+}
+
+// parseSIPrefix returns the SI prefix at the start of s and its length in
+// bytes. It returns unit and 0 if s does not start with a prefix in the range
+// r, so that the caller can try to match the whole of s as a unit.
+func parseSIPrefix(s string, r prefixRange) (prefix, int) {
+	if strings.HasPrefix(s, "da") && r.contains(deca) {
+		return deca, len("da")
+	}
+	_, size := utf8.DecodeRuneInString(s)
+	for _, x := range siPrefixes {
+		if x.symbol == s[:size] && r.contains(x.prefix) {
+			return x.prefix, size
+		}
 	}
+	return unit, 0
 }
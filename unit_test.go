@@ -8,13 +8,20 @@ package unit
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"reflect"
 	"strconv"
 	"testing"
 )
 
-func TestPicoAsString(t *testing.T) {
+func TestFormatSI_Pico(t *testing.T) {
 	data := []struct {
 		in       int64
 		expected string
@@ -79,13 +86,13 @@ func TestPicoAsString(t *testing.T) {
 		{-9223372036854775808, "-9.223M"},
 	}
 	for i, line := range data {
-		if s := picoAsString(line.in); s != line.expected {
-			t.Fatalf("%d: picoAsString(%d).String() = %s != %s", i, line.in, s, line.expected)
+		if s := formatSI(line.in, pico, siFormat{}); s != line.expected {
+			t.Fatalf("%d: formatSI(%d, pico) = %s != %s", i, line.in, s, line.expected)
 		}
 	}
 }
 
-func TestNanoAsString(t *testing.T) {
+func TestFormatSI_Nano(t *testing.T) {
 	data := []struct {
 		in       int64
 		expected string
@@ -162,13 +169,13 @@ func TestNanoAsString(t *testing.T) {
 		{-9223372036854775808, "-9.223G"},
 	}
 	for i, line := range data {
-		if s := nanoAsString(line.in); s != line.expected {
-			t.Fatalf("%d: nanoAsString(%d).String() = %s != %s", i, line.in, s, line.expected)
+		if s := formatSI(line.in, nano, siFormat{}); s != line.expected {
+			t.Fatalf("%d: formatSI(%d, nano) = %s != %s", i, line.in, s, line.expected)
 		}
 	}
 }
 
-func TestMicroAsString(t *testing.T) {
+func TestFormatSI_Micro(t *testing.T) {
 	data := []struct {
 		in       int64
 		expected string
@@ -233,8 +240,8 @@ func TestMicroAsString(t *testing.T) {
 		{-9223372036854775808, "-9.223T"},
 	}
 	for i, line := range data {
-		if s := microAsString(line.in); s != line.expected {
-			t.Fatalf("%d: microAsString(%d).String() = %s != %s", i, line.in, s, line.expected)
+		if s := formatSI(line.in, micro, siFormat{}); s != line.expected {
+			t.Fatalf("%d: formatSI(%d, micro) = %s != %s", i, line.in, s, line.expected)
 		}
 	}
 }
@@ -584,27 +591,65 @@ func Test_decimalMulScale(t *testing.T) {
 func TestPrefix(t *testing.T) {
 	tests := []struct {
 		name   string
-		prefix rune
+		prefix string
 		want   prefix
 		n      int
 	}{
-		{"pico", 'p', pico, 1},
-		{"nano", 'n', nano, 1},
-		{"micro", 'u', micro, 1},
-		{"mu", 'µ', micro, 2},
-		{"milli", 'm', milli, 1},
-		{"unit", 0, unit, 0},
-		{"kilo", 'k', kilo, 1},
-		{"mega", 'M', mega, 1},
-		{"giga", 'G', giga, 1},
-		{"tera", 'T', tera, 1},
+		{"atto", "a", atto, 1},
+		{"femto", "f", femto, 1},
+		{"pico", "p", pico, 1},
+		{"nano", "n", nano, 1},
+		{"micro", "u", micro, 1},
+		{"mu", "µ", micro, 2},
+		{"milli", "m", milli, 1},
+		{"centi", "c", centi, 1},
+		{"deci", "d", deci, 1},
+		{"unit", "", unit, 0},
+		{"deca", "da", deca, 2},
+		{"hecto", "h", hecto, 1},
+		{"kilo", "k", kilo, 1},
+		{"mega", "M", mega, 1},
+		{"giga", "G", giga, 1},
+		{"tera", "T", tera, 1},
+		{"peta", "P", peta, 1},
+		{"exa", "E", exa, 1},
+		{"unit", "x", unit, 0},
+		{"deci", "dm", deci, 1},
+		{"deca", "dam", deca, 2},
 	}
 	for i, tt := range tests {
-		got, n := parseSIPrefix(tt.prefix)
+		got, n := parseSIPrefix(tt.prefix, allPrefixes)
 		if got != tt.want || n != tt.n {
 			t.Errorf("#%d: wanted prefix %d, and len %d, but got prefix %d, and len %d", i, tt.want, tt.n, got, n)
 		}
 	}
+
+	// Prefixes out of the range are not parsed.
+	if got, n := parseSIPrefix("fF", nanoPrefixes); got != unit || n != 0 {
+		t.Errorf("parseSIPrefix(fF, nanoPrefixes) wanted unit but got prefix %d, and len %d", got, n)
+	}
+	if got, n := parseSIPrefix("dam", prefixRange{milli, deci}); got != deci || n != 1 {
+		t.Errorf("parseSIPrefix(dam) wanted deci but got prefix %d, and len %d", got, n)
+	}
+}
+
+func TestPrefixRange_String(t *testing.T) {
+	tests := []struct {
+		in   prefixRange
+		want string
+	}{
+		{allPrefixes, "a,f,p,n,u,µ,m,c,d,da,h,k,M,G,T,P or E"},
+		{picoPrefixes, "f,p,n,u,µ,m,c,d,da,h,k,M or G"},
+		{nanoPrefixes, "p,n,u,µ,m,c,d,da,h,k,M,G or T"},
+		{microPrefixes, "n,u,µ,m,c,d,da,h,k,M,G,T or P"},
+		{prefixRange{kilo, kilo}, "k"},
+		{prefixRange{deci, deca}, "d or da"},
+	}
+	for i, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("#%d: prefixRange.String() expected: %s but got: %s", i, tt.want, got)
+		}
+	}
 }
 
 func TestParseError(t *testing.T) {
@@ -623,6 +668,768 @@ func TestParseError(t *testing.T) {
 	}
 }
 
+// quantity is implemented by all the types of the package.
+type quantity interface {
+	fmt.Stringer
+	json.Marshaler
+	encoding.TextMarshaler
+	AppendText(b []byte) ([]byte, error)
+}
+
+// quantities holds one value of each type of the package.
+var quantities = []quantity{
+	StandardGravity,
+	10 * Degree,
+	90 * DegreePerSecond,
+	12 * SquareMetre,
+	1500 * MilliMetre,
+	3000 * MilliAmpereHour,
+	-2 * MilliAmpere,
+	3300 * MilliVolt,
+	10 * KiloOhm,
+	100 * NanoFarad,
+	250 * MicroSiemens,
+	5 * KiloJoule,
+	12 * Newton,
+	50 * Hertz,
+	470 * MicroHenry,
+	800 * Lumen,
+	15 * Candela,
+	2 * MilliWeber,
+	45 * MicroTesla,
+	KiloGram,
+	60 * Watt,
+	101325 * Pascal,
+	45 * PercentRH,
+	3 * MetrePerSecond,
+	ZeroCelsius + 21*Celsius,
+	TemperatureDifference(-3 * Kelvin),
+	250 * MilliLitre,
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   quantity
+		want string
+	}{
+		{StandardGravity, `"9.80665m/s²"`},
+		{10 * Degree, `"174.53293mrad"`},
+		{2 * RadianPerSecond, `"2rad/s"`},
+		{12 * SquareMetre, `"12m²"`},
+		{1500 * MilliMetre, `"1.5m"`},
+		{3000 * MilliAmpereHour, `"10.8kC"`},
+		{-2 * MilliAmpere, `"-2mA"`},
+		{3300 * MilliVolt, `"3.3V"`},
+		{10 * KiloOhm, `"10kΩ"`},
+		{100 * NanoFarad, `"100nF"`},
+		{250 * MicroSiemens, `"250µS"`},
+		{5 * KiloJoule, `"5kJ"`},
+		{12 * Newton, `"12N"`},
+		{50 * Hertz, `"50Hz"`},
+		{470 * MicroHenry, `"470µH"`},
+		{800 * Lumen, `"800lm"`},
+		{15 * Candela, `"15cd"`},
+		{2 * MilliWeber, `"2mWb"`},
+		{45 * MicroTesla, `"45µT"`},
+		{KiloGram, `"1kg"`},
+		{60 * Watt, `"60W"`},
+		{101325 * Pascal, `"101.325kPa"`},
+		{45 * PercentRH, `"45%rH"`},
+		{3 * MetrePerSecond, `"3m/s"`},
+		{ZeroCelsius + 21*Celsius, `"21°C"`},
+		{TemperatureDifference(-3 * Kelvin), `"-3°C"`},
+		{250 * MilliLitre, `"250mL"`},
+	}
+	for i, tt := range tests {
+		b, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Errorf("#%d: json.Marshal(%s) got unexpected error: %v", i, tt.in, err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("#%d: json.Marshal(%s) expected: %s but got: %s", i, tt.in, tt.want, b)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var v struct {
+		D Distance
+		T Temperature
+		R RelativeHumidity
+		F Frequency
+	}
+	in := `{"D":"12.5mm","T":"-40°F","R":"45.5%","F":1000000}`
+	if err := json.Unmarshal([]byte(in), &v); err != nil {
+		t.Fatalf("json.Unmarshal(%s) got unexpected error: %v", in, err)
+	}
+	if v.D != 12500*MicroMetre {
+		t.Errorf("Distance expected %s but got %s", 12500*MicroMetre, v.D)
+	}
+	if want := ZeroCelsius - 40*Celsius; v.T != want {
+		t.Errorf("Temperature expected %s but got %s", want, v.T)
+	}
+	if v.R != 455*MilliRH {
+		t.Errorf("RelativeHumidity expected %s but got %s", 455*MilliRH, v.R)
+	}
+	if v.F != Hertz {
+		t.Errorf("Frequency expected %s but got %s", Hertz, v.F)
+	}
+
+	fails := []struct {
+		in  string
+		err string
+	}{
+		{`{"D":"12.5"}`, "no unit provided; need m, Mile, in, ft or Yard"},
+		{`{"D":"12.5kg"}`, "unknown unit provided; need m, Mile, in, ft or Yard"},
+		{`{"D":1.5}`, "expected a JSON string or integer, got 1.5"},
+		{`{"D":9223372036854775808}`, "exceeds maximum"},
+		{`{"D":-9223372036854775809}`, "exceeds minimum"},
+		{`{"R":10000001}`, "maximum value is 100%rH"},
+		{`{"R":-1}`, "minimum value is 0%rH"},
+	}
+	for i, tt := range fails {
+		if err := json.Unmarshal([]byte(tt.in), &v); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: json.Unmarshal(%s) \nexpected: %s\ngot:      %v", i, tt.in, tt.err, err)
+		}
+	}
+}
+
+func TestUnmarshalJSON_Null(t *testing.T) {
+	d := Metre
+	if err := json.Unmarshal([]byte("null"), &d); err != nil {
+		t.Fatalf("json.Unmarshal(null) got unexpected error: %v", err)
+	}
+	if d != Metre {
+		t.Fatalf("json.Unmarshal(null) expected %s to be unchanged, got %s", Metre, d)
+	}
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+	x := 1234 * MilliGram
+	b, err := json.Marshal(x)
+	if err != nil {
+		t.Fatalf("json.Marshal(%s) failed: %v", x, err)
+	}
+	var y Mass
+	if err := json.Unmarshal(b, &y); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed: %v", b, err)
+	}
+	if x != y {
+		t.Fatalf("Mass expected %s to equal %s", x, y)
+	}
+
+	// Values that String rounds must still round-trip exactly.
+	var v struct {
+		D Distance
+		A Angle
+		M Mass
+		T Temperature
+	}
+	v.D, v.A, v.M, v.T = Mile, Radian+1, Slug, ZeroCelsius+36600*MilliKelvin+1
+	want := v
+	b, err = json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) failed: %v", v, err)
+	}
+	v.D, v.A, v.M, v.T = 0, 0, 0, 0
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed: %v", b, err)
+	}
+	if v != want {
+		t.Fatalf("json.Unmarshal(%s) expected %+v but got %+v", b, want, v)
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	for i, q := range quantities {
+		b, err := q.MarshalText()
+		if err != nil {
+			t.Errorf("#%d: %s.MarshalText() got unexpected error: %v", i, q, err)
+			continue
+		}
+		// The text must parse back to the same value, not to the value
+		// rounded by String.
+		p := reflect.New(reflect.TypeOf(q))
+		if err := p.Interface().(encoding.TextUnmarshaler).UnmarshalText(b); err != nil {
+			t.Errorf("#%d: %s.MarshalText() = %s does not parse: %v", i, q, b, err)
+		} else if got := p.Elem().Interface(); got != q {
+			t.Errorf("#%d: %s.MarshalText() = %s parses back to %s", i, q, b, got)
+		}
+		a, err := q.AppendText([]byte("x="))
+		if err != nil {
+			t.Errorf("#%d: %s.AppendText() got unexpected error: %v", i, q, err)
+			continue
+		}
+		if string(a) != "x="+string(b) {
+			t.Errorf("#%d: %s.AppendText() expected: x=%s but got: %s", i, q, b, a)
+		}
+	}
+}
+
+func TestMarshalText_Exact(t *testing.T) {
+	data := []struct {
+		in       encoding.TextMarshaler
+		expected string
+	}{
+		{StandardGravity, "9.80665m/s²"},
+		{10 * Degree, "174.53293mrad"},
+		{Mile, "1.609344km"},
+		{1500 * MilliMetre, "1.5m"},
+		{1234567 * SquareMillimetre, "1.234567m²"},
+		{45678 * TenthMicroRH, "0.45678%rH"},
+		{-1 * NanoKelvin, "-273.150000001°C"},
+		{ZeroCelsius + 36600*MilliKelvin + 1, "36.600000001°C"},
+		{0 * Newton, "0N"},
+	}
+	for i, line := range data {
+		b, err := line.in.MarshalText()
+		if err != nil {
+			t.Errorf("#%d: MarshalText() got unexpected error: %v", i, err)
+		} else if string(b) != line.expected {
+			t.Errorf("#%d: MarshalText() expected: %s but got: %s", i, line.expected, b)
+		}
+	}
+}
+
+func TestAppendText_Allocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	for i, q := range quantities {
+		allocs := testing.AllocsPerRun(100, func() {
+			buf, _ = q.AppendText(buf[:0])
+		})
+		if allocs != 0 {
+			t.Errorf("#%d: %s.AppendText() expected no allocations but got %v", i, q, allocs)
+		}
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var v struct {
+		A Angle
+		R RelativeHumidity
+		T Temperature
+	}
+	tests := []struct {
+		u  encoding.TextUnmarshaler
+		in string
+	}{
+		{&v.A, "90°"},
+		{&v.R, "45.5%rH"},
+		{&v.T, "21°C"},
+	}
+	for i, tt := range tests {
+		if err := tt.u.UnmarshalText([]byte(tt.in)); err != nil {
+			t.Errorf("#%d: UnmarshalText(%s) got unexpected error: %v", i, tt.in, err)
+		}
+	}
+	if v.A != 90*Degree {
+		t.Errorf("Angle expected %s but got %s", 90*Degree, v.A)
+	}
+	if v.R != 455*MilliRH {
+		t.Errorf("RelativeHumidity expected %s but got %s", 455*MilliRH, v.R)
+	}
+	if want := ZeroCelsius + 21*Celsius; v.T != want {
+		t.Errorf("Temperature expected %s but got %s", want, v.T)
+	}
+
+	var d Distance
+	if err := d.UnmarshalText([]byte("12")); err == nil || err.Error() != "no unit provided; need m, Mile, in, ft or Yard" {
+		t.Errorf("Distance.UnmarshalText(12) got unexpected error: %v", err)
+	}
+}
+
+func BenchmarkDistanceAppendText(b *testing.B) {
+	d := 1234567 * MicroMetre
+	buf := make([]byte, 0, 32)
+	for i := 0; i < b.N; i++ {
+		buf, _ = d.AppendText(buf[:0])
+	}
+}
+
+// fakeDriver is a database/sql driver storing a single table in memory.
+//
+// Executing a statement appends its arguments as a row and querying returns
+// every row, regardless of the SQL text.
+type fakeDriver struct {
+	rows [][]driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{d}, nil
+}
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{c.d}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	d *fakeDriver
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.rows = append(s.d.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{rows: s.d.rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	i    int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	c := make([]string, len(r.rows[0]))
+	for i := range c {
+		c[i] = "c" + strconv.Itoa(i)
+	}
+	return c
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i == len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.i])
+	r.i++
+	return nil
+}
+
+func openFakeDB(t *testing.T) (*sql.DB, *fakeDriver) {
+	d := &fakeDriver{}
+	db := sql.OpenDB(driverConnector{d})
+	t.Cleanup(func() { db.Close() })
+	return db, d
+}
+
+// driverConnector returns connections to a fakeDriver without registering it.
+type driverConnector struct {
+	d *fakeDriver
+}
+
+func (c driverConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.d.Open("")
+}
+
+func (c driverConnector) Driver() driver.Driver {
+	return c.d
+}
+
+func TestSQL_RoundTrip(t *testing.T) {
+	db, d := openFakeDB(t)
+	m := 1234567 * MicroGram
+	e := 3 * KiloWattHour
+	r := 455 * MilliRH
+	if _, err := db.Exec("INSERT", m, e, r); err != nil {
+		t.Fatalf("Exec() failed: %v", err)
+	}
+	want := []driver.Value{int64(m), int64(e), int64(r)}
+	for i, v := range d.rows[0] {
+		if v != want[i] {
+			t.Errorf("#%d: stored %v(%T) but expected %v", i, v, v, want[i])
+		}
+	}
+
+	var gotM Mass
+	var gotE Energy
+	var gotR RelativeHumidity
+	if err := db.QueryRow("SELECT").Scan(&gotM, &gotE, &gotR); err != nil {
+		t.Fatalf("Scan() failed: %v", err)
+	}
+	if gotM != m {
+		t.Errorf("Mass expected %s but got %s", m, gotM)
+	}
+	if gotE != e {
+		t.Errorf("Energy expected %s but got %s", e, gotE)
+	}
+	if gotR != r {
+		t.Errorf("RelativeHumidity expected %s but got %s", r, gotR)
+	}
+}
+
+func TestSQL_ScanText(t *testing.T) {
+	db, _ := openFakeDB(t)
+	if _, err := db.Exec("INSERT", "1.5kg", []byte("-40°F"), "25°"); err != nil {
+		t.Fatalf("Exec() failed: %v", err)
+	}
+	var m Mass
+	var tp Temperature
+	var a Angle
+	if err := db.QueryRow("SELECT").Scan(&m, &tp, &a); err != nil {
+		t.Fatalf("Scan() failed: %v", err)
+	}
+	if m != 1500*Gram {
+		t.Errorf("Mass expected %s but got %s", 1500*Gram, m)
+	}
+	if want := ZeroCelsius - 40*Celsius; tp != want {
+		t.Errorf("Temperature expected %s but got %s", want, tp)
+	}
+	if a != 25*Degree {
+		t.Errorf("Angle expected %s but got %s", 25*Degree, a)
+	}
+}
+
+func TestSQL_ScanNull(t *testing.T) {
+	db, _ := openFakeDB(t)
+	if _, err := db.Exec("INSERT", nil); err != nil {
+		t.Fatalf("Exec() failed: %v", err)
+	}
+	var p Pressure
+	if err := db.QueryRow("SELECT").Scan(&p); err == nil {
+		t.Fatal("Scan() of NULL expected an error")
+	}
+	var n sql.Null[Pressure]
+	if err := db.QueryRow("SELECT").Scan(&n); err != nil {
+		t.Fatalf("Scan() of NULL into sql.Null failed: %v", err)
+	}
+	if n.Valid {
+		t.Fatal("Scan() of NULL into sql.Null expected an invalid value")
+	}
+}
+
+func TestScan(t *testing.T) {
+	fails := []struct {
+		in  any
+		err string
+	}{
+		{nil, "cannot scan NULL; use sql.Null"},
+		{1.5, "unsupported database type; need int64, string or []byte"},
+		{"1.5", "no unit provided; need N, lbf, gf, dyn, kip or ozf"},
+		{[]byte("1.5kg"), "unknown unit provided; need N, lbf, gf, dyn, kip or ozf"},
+	}
+	for i, tt := range fails {
+		var f Force
+		if err := f.Scan(tt.in); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: Force.Scan(%v) \nexpected: %s\ngot:      %v", i, tt.in, tt.err, err)
+		}
+	}
+	var r RelativeHumidity
+	if err := r.Scan(int64(-1)); err == nil || err.Error() != "minimum value is 0%rH" {
+		t.Errorf("RelativeHumidity.Scan(-1) got unexpected error: %v", err)
+	}
+}
+
+func TestAppendDecimal(t *testing.T) {
+	tests := []struct {
+		u     uint64
+		shift int
+		prec  int
+		want  string
+	}{
+		{0, 0, 0, "0"},
+		{0, 3, 2, "0.00"},
+		{0, 3, -1, "0"},
+		{1234, 0, 0, "1234"},
+		{1234, 0, 2, "1234.00"},
+		{1234, 3, 3, "1.234"},
+		{1234, 3, 1, "1.2"},
+		{1250, 3, 1, "1.3"},
+		{1234, 3, 0, "1"},
+		{1234, 3, 6, "1.234000"},
+		{1234, 6, 6, "0.001234"},
+		{1234, 6, 4, "0.0012"},
+		{1234, 6, -1, "0.001234"},
+		{1200, 3, -1, "1.2"},
+		{1000, 3, -1, "1"},
+		{12, -3, 0, "12000"},
+		{12, -3, 2, "12000.00"},
+		{12, -3, -1, "12000"},
+		{9223372036854775807, 18, 3, "9.223"},
+		{9223372036854775807, 19, 0, "1"},
+		{9223372036854775807, 20, 0, "0"},
+		{1, 21, 21, "0.000000000000000000001"},
+	}
+	for i, tt := range tests {
+		if got := string(appendDecimal(nil, tt.u, tt.shift, tt.prec)); got != tt.want {
+			t.Errorf("#%d: appendDecimal(%d, %d, %d) expected: %s but got: %s", i, tt.u, tt.shift, tt.prec, tt.want, got)
+		}
+	}
+}
+
+func TestAppendSI(t *testing.T) {
+	fixed := func(prec int) siFormat { return siFormat{mode: siFixed, digits: prec} }
+	tests := []struct {
+		v    int64
+		base prefix
+		f    siFormat
+		want string
+	}{
+		{0, nano, fixed(2), "0.00"},
+		{0, pico, fixed(0), "0"},
+		{1, nano, fixed(2), "1.00n"},
+		{1234567, nano, siFormat{}, "1.235m"},
+		{1234567, nano, fixed(0), "1m"},
+		{1234567, nano, fixed(5), "1.23457m"},
+		{-1234567, nano, fixed(5), "-1.23457m"},
+		{999999, nano, fixed(3), "999.999µ"},
+		{999999, nano, fixed(2), "1.00m"},
+		{999999, nano, fixed(0), "1m"},
+		{12000000, pico, fixed(6), "12.000000µ"},
+		{5, micro, fixed(1), "5.0µ"},
+		{9223372036854775807, nano, fixed(3), "9.223G"},
+		{-9223372036854775807, micro, fixed(1), "-9.2T"},
+	}
+	for i, tt := range tests {
+		if got := string(appendSI(nil, tt.v, tt.base, tt.f)); got != tt.want {
+			t.Errorf("#%d: appendSI(%d, %d, %+v) expected: %s but got: %s", i, tt.v, tt.base, tt.f, tt.want, got)
+		}
+	}
+}
+
+func TestAppendSI_Allocs(t *testing.T) {
+	buf := make([]byte, 0, 32)
+	formats := []siFormat{
+		{},
+		{mode: siFixed, digits: 6},
+	}
+	for i, f := range formats {
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = appendSI(buf[:0], -1234567, nano, f)
+		})
+		if allocs != 0 {
+			t.Errorf("#%d: appendSI(%+v) expected no allocations but got %v", i, f, allocs)
+		}
+	}
+}
+
+func benchmarkAppendSI(b *testing.B, f siFormat) {
+	buf := make([]byte, 0, 32)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = appendSI(buf[:0], -1234567, nano, f)
+	}
+}
+
+func BenchmarkAppendSI(b *testing.B) {
+	benchmarkAppendSI(b, siFormat{})
+}
+
+func BenchmarkAppendSIFixed(b *testing.B) {
+	benchmarkAppendSI(b, siFormat{mode: siFixed, digits: 6})
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		in     any
+		want   string
+	}{
+		{"%v", 1234500 * MicroMetre, "1.234m"},
+		{"%s", 1234500 * MicroMetre, "1.234m"},
+		{"%.6v", 1234500 * MicroMetre, "1.234500m"},
+		{"%.1v", 1234500 * MicroMetre, "1.2m"},
+		{"%10v", 1234500 * MicroMetre, "    1.234m"},
+		{"%-10v|", 1234500 * MicroMetre, "1.234m    |"},
+		{"%8.1v", 12 * MicroFarad, "  12.0µF"},
+		{"%.2v", Distance(0), "0.00m"},
+		{"%.2v", Force(0), "0.00N"},
+		{"%.0v", ElectricalCapacitance(0), "0F"},
+		{"%+v", 1234500 * MicroMetre, "1.234m (1234500000nm)"},
+		{"%+v", 50 * Hertz, "50Hz (50000000µHz)"},
+		{"%q", 1234500 * MicroMetre, `"1.234m"`},
+		{"%d", 1234500 * MicroMetre, "1234500000"},
+		{"%#v", 1234500 * MicroMetre, "1234500000"},
+		{"%x", Gram, "3b9aca00"},
+		{"%.1v", 10 * Degree, "10.0°"},
+		{"%v", ZeroCelsius + 215*Celsius/10, "21.500°C"},
+		{"%.1v", ZeroCelsius + 215*Celsius/10, "21.5°C"},
+		{"%.2v", 455 * MilliRH, "45.50%rH"},
+		{"%.1v", 12500 * SquareCentimetre, "1.3m²"},
+		{"%+v", SquareMetre, "1m² (1000000mm²)"},
+		{"%v", struct{ D Distance }{Metre}, "{1m}"},
+	}
+	for i, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.in); got != tt.want {
+			t.Errorf("#%d: Sprintf(%q) expected: %s but got: %s", i, tt.format, tt.want, got)
+		}
+	}
+}
+
+func TestFormatUnit(t *testing.T) {
+	type unitFormatter interface {
+		FormatUnit(symbol string, precision int) (string, error)
+	}
+	succeeds := []struct {
+		in        unitFormatter
+		symbol    string
+		precision int
+		want      string
+	}{
+		{1234500 * MicroMetre, "mm", 1, "1234.5mm"},
+		{1234500 * MicroMetre, "mm", -1, "1234.5mm"},
+		{1234500 * MicroMetre, "km", 4, "0.0012km"},
+		{1234500 * MicroMetre, "pm", 0, "1234500000000pm"},
+		{12 * MicroFarad, "F", -1, "0.000012F"},
+		{12 * MicroFarad, "nF", 2, "12000.00nF"},
+		{10 * KiloOhm, "Ohm", 0, "10000Ohm"},
+		{10 * KiloOhm, "MΩ", 3, "0.010MΩ"},
+		{-2 * MilliAmpere, "A", 3, "-0.002A"},
+		{3000 * MilliAmpereHour, "mAh", -1, "3000mAh"},
+		{10 * KiloCoulomb, "Ah", 2, "2.78Ah"},
+		{50 * Hertz, "kHz", 2, "0.05kHz"},
+		{50 * Hertz, "rpm", -1, "3000rpm"},
+		{3 * KiloWattHour, "kWh", 1, "3.0kWh"},
+		{1 * Joule, "cal", -1, "0.2390057361376673cal"},
+		{160 * NanoJoule, "TeV", 3, "0.999TeV"},
+		{Horsepower, "hp", 3, "1.000hp"},
+		{12 * KiloWatt, "kBTU/h", 1, "40.9kBTU/h"},
+		{Watt, "dBm", 1, "30.0dBm"},
+		{MilliWatt, "dBW", -1, "-30dBW"},
+		{USGallon, "L", -1, "3.785411784L"},
+		{Litre, "UScup", 3, "4.227UScup"},
+		{USGallon, "USgal", 0, "1USgal"},
+		{ImperialPint, "impfloz", -1, "20impfloz"},
+		{1500 * Litre, "m³", 1, "1.5m³"},
+		{101325 * Pascal, "hPa", 2, "1013.25hPa"},
+		{Stone, "lb", -1, "14lb"},
+		{Slug, "slug", 3, "1.000slug"},
+		{1000 * Slug, "kslug", 1, "1.0kslug"},
+		{TroyOunce, "g", -1, "31.1034768g"},
+		{Carat, "ct", 0, "1ct"},
+		{NauticalMile, "m", 0, "1852m"},
+		{Metre, "thou", 2, "39370.08thou"},
+		{Metre, "cm", -1, "100cm"},
+		{Metre, "dam", 1, "0.1dam"},
+		{Metre, "Em", -1, "0.000000000000000001Em"},
+		{PicoFarad, "fF", 0, "1000fF"},
+		{Litre, "dL", 0, "10dL"},
+		{Knot, "kn", 3, "1.000kn"},
+		{MetrePerSecond, "fpm", 1, "196.9fpm"},
+		{3333333333 * NanoMetrePerSecond, "/km", 0, "5:00/km"},
+		{4470400 * MicroMetrePerSecond, "/mi", 1, "6:00.0/mi"},
+		{MetrePerSecond / 4, "/mi", -1, "1:47:17/mi"},
+		{Speed(109), "/km", 0, "2548419:58:47/km"},
+		{2 * Hertz, "bpm", 0, "120bpm"},
+		{3 * MetrePerSecond, "mm/s", 0, "3000mm/s"},
+		{ZeroCelsius, "K", 2, "273.15K"},
+		{ZeroCelsius + 21*Celsius, "°C", 1, "21.0°C"},
+		{ZeroCelsius + 21*Celsius, "mC", 0, "21000mC"},
+		{90 * Degree, "°", 1, "90.0°"},
+		{Degree, "arcmin", -1, "60arcmin"},
+		{Pi, "gon", 0, "200gon"},
+		{Pi, "grad", 0, "200grad"},
+		{Pi, "kgrad", 1, "0.2kgrad"},
+		{Theta, "mil", 0, "6400mil"},
+		{Radian, "mrad", 0, "1000mrad"},
+		{455 * MilliRH, "%", 2, "45.50%"},
+		{Mile, "ft", 0, "5280ft"},
+		{Metre, "ft", 2, "3.28ft"},
+		{Metre, "in", -1, "39.37007874015748in"},
+		{10 * Foot, "Yard", -1, "3.3333333333333335Yard"},
+		{254 * MilliMetre, "in", -1, "10in"},
+		{1609344 * MilliMetre, "kmile", 3, "0.001kmile"},
+		{KiloGram, "lb", 4, "2.2046lb"},
+		{PoundMass, "oz", -1, "16oz"},
+		{10 * Newton, "lbf", 3, "2.248lbf"},
+		{PoundForce, "lbf", 6, "1.000000lbf"},
+		{EarthGravity, "kgf", -1, "1kgf"},
+		{Newton, "dyn", 0, "100000dyn"},
+		{10 * KiloNewton, "kip", 3, "2.248kip"},
+		{Newton, "ozf", 2, "3.60ozf"},
+		{100 * KilometrePerHour, "kph", -1, "100kph"},
+		{MilePerHour, "mph", 1, "1.0mph"},
+		{MetrePerSecond, "fps", 2, "3.28fps"},
+		{ZeroCelsius, "°F", 1, "32.0°F"},
+		{ZeroCelsius + 37*Celsius, "°F", 1, "98.6°F"},
+		{ZeroFahrenheit, "F", -1, "0F"},
+		{Temperature(0), "°F", 2, "-459.67°F"},
+		{ZeroCelsius, "°R", 2, "491.67°R"},
+		{Temperature(0), "R", -1, "0R"},
+		{ZeroCelsius + 100*Celsius, "k°R", 3, "0.672k°R"},
+		{45 * MicroTesla, "mG", -1, "450mG"},
+		{Weber, "Mx", 0, "100000000Mx"},
+		{10 * MicroWeber, "kMx", -1, "1kMx"},
+		{470 * MicroHenry, "mH", 2, "0.47mH"},
+		{Siemens, "mho", 0, "1mho"},
+		{250 * MicroSiemens, "mS", -1, "0.25mS"},
+		{Tesla, "kG", 0, "10kG"},
+		{Gauss, "µT", 0, "100µT"},
+		{TemperatureDifference(5 * Kelvin), "°F", 1, "9.0°F"},
+		{TemperatureDifference(-5 * Kelvin), "K", -1, "-5K"},
+		{Pi, "°", 3, "180.000°"},
+		{Theta, "rad", 4, "6.2832rad"},
+		{StandardGravity, "g", -1, "1g"},
+		{StandardGravity, "m/s²", -1, "9.80665m/s²"},
+		{Gal, "mGal", 0, "1000mGal"},
+		{MetrePerSecondSquared, "ft/s²", 3, "3.281ft/s²"},
+		{60 * RevolutionPerMinute, "rpm", 3, "60.000rpm"},
+		{90 * DegreePerSecond, "°/s", 2, "90.00°/s"},
+		{RadianPerSecond, "mrad/s", -1, "1000mrad/s"},
+		{Hectare, "ha", -1, "1ha"},
+		{SquareMetre, "ft²", 3, "10.764ft²"},
+		{SquareMile, "acre", -1, "640acre"},
+		{SquareKilometre, "sq mi", 4, "0.3861sq mi"},
+		{SquareCentimetre, "m²", -1, "0.0001m²"},
+	}
+	for i, tt := range succeeds {
+		got, err := tt.in.FormatUnit(tt.symbol, tt.precision)
+		if err != nil {
+			t.Errorf("#%d: FormatUnit(%s, %d) got unexpected error: %v", i, tt.symbol, tt.precision, err)
+		}
+		if got != tt.want {
+			t.Errorf("#%d: FormatUnit(%s, %d) expected: %s but got: %s", i, tt.symbol, tt.precision, tt.want, got)
+		}
+	}
+
+	fails := []struct {
+		in     unitFormatter
+		symbol string
+		err    string
+	}{
+		{Metre, "g", "unknown unit provided; need Mile, mile, Yard, yard, ft, in, thou, mil, nmi, NM, ch, fur or m"},
+		{Gram, "kN", "unknown unit provided; need g, lb, ozt, oz, st, ShortTon, shortton, LongTon, longton, gr, ct or slug"},
+		{Metre, "xm", "unknown unit prefix; valid prefixes for \"m\" are a,f,p,n,u,µ,m,c,d,da,h,k,M,G,T,P or E"},
+		{Metre, "mmm", "unknown unit prefix; valid prefixes for \"m\" are a,f,p,n,u,µ,m,c,d,da,h,k,M,G,T,P or E"},
+		{Ohm, "A", "unknown unit provided; need Ω, Ohm or ohm"},
+		{ZeroCelsius, "rpm", "unknown unit provided; need K, °C, C, °F, F, °R or R"},
+		{Degree, "rev", "unknown unit provided; need °, Deg, deg, Rad, rad, arcmin, arcsec, gon, grad, turn or mil"},
+		{Degree, "xgrad", "unknown unit prefix; valid prefixes for \"grad\" are a,f,p,n,u,µ,m,c,d,da,h,k,M,G,T,P or E"},
+		{Slug, "xslug", "unknown unit prefix; valid prefixes for \"slug\" are a,f,p,n,u,µ,m,c,d,da,h,k,M,G,T,P or E"},
+		{PercentRH, "rH", "unknown unit provided; need %rH or %"},
+		{USGallon, "kUSgal", "\"USgal\" does not accept an SI prefix"},
+		{Litre, "mft³", "\"ft³\" does not accept an SI prefix"},
+		{Litre, "N", "unknown unit provided; need L, USgal, impgal, m³ or ft³"},
+		{SquareMetre, "Mm²", "unknown unit provided; need m², cm², mm², km², ha, ft², in², acre or sq mi"},
+		{Speed(1), "/km", "speed of 1nm/s is too slow to be expressed as a pace"},
+		{-MetrePerSecond, "/km", "cannot express a speed of -1m/s as a pace"},
+	}
+	for i, tt := range fails {
+		if _, err := tt.in.FormatUnit(tt.symbol, 0); err == nil || err.Error() != tt.err {
+			t.Errorf("#%d: FormatUnit(%s) \nexpected: %s\ngot:      %v", i, tt.symbol, tt.err, err)
+		}
+	}
+}
+
 func TestMaxInt64(t *testing.T) {
 	if strconv.FormatUint(maxInt64, 10) != maxInt64Str {
 		t.Fatal("unexpected text representation of max")
@@ -654,7 +1461,6 @@ func TestValueOfUnitString(t *testing.T) {
 		{"-1M", pico, -1000000000000000000, 3},
 		{"-9.223372036854775807M", pico, -9223372036854775807, 22},
 		{"-9223372036854775807p", pico, -9223372036854775807, 21},
-		{"1p", nano, 0, 2},
 		{"1n", nano, 1, 2},
 		{"1u", nano, 1000, 2},
 		{"1µ", nano, 1000, 3},
@@ -664,7 +1470,6 @@ func TestValueOfUnitString(t *testing.T) {
 		{"1G", nano, 1000000000000000000, 2},
 		{"9.223372036854775807G", nano, 9223372036854775807, 21},
 		{"9223372036854775807n", nano, 9223372036854775807, 20},
-		{"-1p", nano, -0, 3},
 		{"-1n", nano, -1, 3},
 		{"-1u", nano, -1000, 3},
 		{"-1µ", nano, -1000, 4},
@@ -673,9 +1478,9 @@ func TestValueOfUnitString(t *testing.T) {
 		{"-1M", nano, -1000000000000000, 3},
 		{"-1G", nano, -1000000000000000000, 3},
 		{"-9.223372036854775807G", nano, -9223372036854775807, 22},
+		{"0p", nano, 0, 2},
+		{"500p", nano, 1, 4},
 		{"-9223372036854775807n", nano, -9223372036854775807, 21},
-		{"1p", micro, 0, 2},
-		{"1n", micro, 0, 2},
 		{"1u", micro, 1, 2},
 		{"1µ", micro, 1, 3},
 		{"1m", micro, 1000, 2},
@@ -685,8 +1490,6 @@ func TestValueOfUnitString(t *testing.T) {
 		{"1T", micro, 1000000000000000000, 2},
 		{"9.223372036854775807T", micro, 9223372036854775807, 21},
 		{"9223372036854775807u", micro, 9223372036854775807, 20},
-		{"-1p", micro, -0, 3},
-		{"-1n", micro, -0, 3},
 		{"-1u", micro, -1, 3},
 		{"-1µ", micro, -1, 4},
 		{"-1m", micro, -1000, 3},
@@ -714,12 +1517,17 @@ func TestValueOfUnitString(t *testing.T) {
 		{"-9223372036854775808p", pico},
 		{"-9223372036854775808n", nano},
 		{"-9223372036854775808u", micro},
+		{"1p", nano},
+		{"-1p", nano},
+		{"1n", micro},
+		{"-1n", micro},
+		{"0.4n", nano},
 		{"not a number", nano},
 		{string([]byte{0x31, 0x01}), nano}, // 0x01 is a invalid utf8 start byte.
 	}
 
 	for i, tt := range succeeds {
-		got, used, err := valueOfUnitString(tt.in, tt.uintbase)
+		got, used, err := valueOfUnitString(tt.in, tt.uintbase, allPrefixes)
 		if got != tt.expected {
 			t.Errorf("#%d: valueOfUnitString(%s,%d) wanted: %v(%d) but got: %v(%d)", i, tt.in, tt.uintbase, tt.expected, tt.expected, got, got)
 		}
@@ -732,7 +1540,7 @@ func TestValueOfUnitString(t *testing.T) {
 	}
 
 	for i, tt := range fails {
-		if _, _, err := valueOfUnitString(tt.in, tt.prefix); err == nil {
+		if _, _, err := valueOfUnitString(tt.in, tt.prefix, allPrefixes); err == nil {
 			t.Errorf("#%d: valueOfUnitString(%s,%d) expected an error", i, tt.in, tt.prefix)
 		}
 	}
@@ -1,7 +1,10 @@
 package unit
 
 import (
+	"database/sql/driver"
 	"errors"
+	"fmt"
+	"strconv"
 	"unicode/utf8"
 )
 
@@ -12,21 +15,100 @@ type Volume int64
 
 // String returns the volume formatted as a string in litres.
 func (v Volume) String() string {
-	return nanoAsString(int64(v)) + "L"
+	return formatSI(int64(v), nano, siFormat{}) + "L"
 }
 
-// Set sets the Volume to the value represented by s. The unit to be provided is "L"
-// with an optional SI prefix: "n", "u", "µ", "m", "k", "M", or "G".
+// Format implements fmt.Formatter. It supports the precision, width and flags
+// described in the package documentation.
+func (v Volume) Format(f fmt.State, verb rune) {
+	formatQuantity(f, verb, int64(v), "nL", func(b []byte, prec int) []byte {
+		return append(appendSI(b, int64(v), nano, siPrecision(prec)), 'L')
+	})
+}
+
+// FormatUnit returns the volume formatted in one of the units accepted by Set,
+// for example "mL", "USgal", "impfloz" or "m³", rounded to precision digits
+// after the decimal point. As with Set, only "L" accepts an SI prefix. A
+// negative precision uses as many digits as necessary to represent the volume
+// exactly.
+func (v Volume) FormatUnit(symbol string, precision int) (string, error) {
+	// Same conversion factors as Set.
+	num, den := int64(0), int64(1)
+	switch symbol {
+	case "USgal":
+		num = int64(USGallon)
+	case "USqt":
+		num = int64(USQuart)
+	case "USpt":
+		num = int64(USPint)
+	case "UScup":
+		num, den = 473176473, 2
+	case "USfloz":
+		num, den = 473176473, 16
+	case "UStbsp":
+		num, den = 473176473, 32
+	case "UStsp":
+		num, den = 157725491, 32
+	case "impgal":
+		num = int64(ImperialGallon)
+	case "impqt":
+		num = int64(ImperialQuart)
+	case "imppt":
+		num = int64(ImperialPint)
+	case "impcup":
+		num = int64(ImperialCup)
+	case "impfloz":
+		num, den = 56826125, 2
+	case "imptbsp":
+		num, den = 284130625, 16
+	case "imptsp":
+		num, den = 284130625, 48
+	case "m³", "m3":
+		num = int64(CubicMetre)
+	case "cm³", "cm3", "cc":
+		num = int64(CubicCentimetre)
+	case "mm³", "mm3":
+		num = int64(CubicMillimetre)
+	case "ft³", "ft3":
+		num = int64(CubicFoot)
+	case "in³", "in3":
+		num = int64(CubicInch)
+	default:
+		if found := hasSuffixes(symbol, "L"); found != "" {
+			return formatUnit(int64(v), symbol, precision, scaledUnit{"L", int64(Litre), 1})
+		}
+		if found := hasSuffixes(symbol, volumeUnits...); found != "" {
+			return "", errors.New("\"" + found + "\" does not accept an SI prefix")
+		}
+		return "", incorrectUnitErr("L, USgal, impgal, m³ or ft³")
+	}
+	return formatUnit(int64(v), symbol, precision, scaledUnit{symbol, num, den})
+}
+
+// Set sets the Volume to the value represented by s. Units are to be provided
+// in "L" with an optional SI prefix: "p", "n", "u", "µ", "m", "c", "d", "da",
+// "h", "k", "M", "G" or "T". The following units are also accepted, without a
+// prefix:
+//
+//   - US customary units "USgal", "USqt", "USpt", "UScup", "USfloz", "UStbsp"
+//     and "UStsp".
+//   - Imperial units "impgal", "impqt", "imppt", "impcup", "impfloz",
+//     "imptbsp" and "imptsp".
+//   - Cubic units "m³", "cm³", "cc", "mm³", "ft³" and "in³". They may also be
+//     written with a plain "3", for example "m3".
+//
+// Since the US and imperial units differ, "gal", "qt", "pt", "cup", "floz",
+// "tbsp" and "tsp" alone are rejected.
 func (v *Volume) Set(s string) error {
 	d, n, err := atod(s)
 	if err != nil {
 		if e, ok := err.(*parseError); ok {
 			switch e.error {
 			case errNotANumber:
-				if found := hasSuffixes(s[n:], "L"); found != "" {
+				if found := hasSuffixes(s[n:], volumeUnits...); found != "" {
 					return err
 				}
-				return notNumberUnitErr("L")
+				return notNumberUnitErr("L, USgal, impgal, m³ or ft³")
 			case errOverflowsInt64:
 				return maxValueErr(maxVolume.String())
 			case errOverflowsInt64Negative:
@@ -42,11 +124,23 @@ func (v *Volume) Set(s string) error {
 		if r <= 1 || rsize == 0 {
 			return errors.New("unexpected end of string")
 		}
-		var siSize int
-		si, siSize = parseSIPrefix(r)
-		n += siSize
+		switch s[n:] {
+		case "m³", "m3", "cm³", "cm3", "cc", "mm³", "mm3", "ft³", "ft3", "in³", "in3",
+			"USgal", "USqt", "USpt", "UScup", "USfloz", "UStbsp", "UStsp",
+			"impgal", "impqt", "imppt", "impcup", "impfloz", "imptbsp", "imptsp",
+			"gal", "qt", "pt", "cup", "floz", "tbsp", "tsp":
+			// The first letter of the unit is not an SI prefix.
+		default:
+			var siSize int
+			si, siSize = parseSIPrefix(s[n:], nanoPrefixes)
+			n += siSize
+		}
 	}
 
+	// factor is the value of the unit in nano litres and maxUnit is the largest
+	// representable value in the unit.
+	var factor decimal
+	var maxUnit int64
 	switch s[n:] {
 	case "L":
 		x, overflow := dtoi(d, int(si-nano))
@@ -56,18 +150,140 @@ func (v *Volume) Set(s string) error {
 			}
 			return maxValueErr(maxVolume.String())
 		}
+		if roundsToZero(d, x) {
+			return resolutionErr(NanoLitre.String())
+		}
 		*v = Volume(x)
+		return nil
+	case "USgal":
+		factor, maxUnit = decimal{base: 3785411784}, 2436557120
+	case "USqt":
+		factor, maxUnit = decimal{base: 946352946}, 9746228482
+	case "USpt":
+		factor, maxUnit = decimal{base: 473176473}, 19492456965
+	case "UScup":
+		factor, maxUnit = decimal{base: 2365882365, exp: -1}, 38984913930
+	case "USfloz":
+		factor, maxUnit = decimal{base: 295735295625, exp: -4}, 311879311441
+	case "UStbsp":
+		factor, maxUnit = decimal{base: 1478676478125, exp: -5}, 623758622883
+	case "UStsp":
+		factor, maxUnit = decimal{base: 492892159375, exp: -5}, 1871275868650
+	case "impgal":
+		factor, maxUnit = decimal{base: 454609, exp: 4}, 2028858213
+	case "impqt":
+		factor, maxUnit = decimal{base: 11365225, exp: 2}, 8115432854
+	case "imppt":
+		factor, maxUnit = decimal{base: 56826125, exp: 1}, 16230865709
+	case "impcup":
+		factor, maxUnit = decimal{base: 284130625}, 32461731419
+	case "impfloz":
+		factor, maxUnit = decimal{base: 284130625, exp: -1}, 324617314196
+	case "imptbsp":
+		factor, maxUnit = decimal{base: 177581640625, exp: -4}, 519387702714
+	case "imptsp":
+		// 1imptsp is 5919388.0208333…nL, rounded here to 14 significant figures.
+		factor, maxUnit = decimal{base: 59193880208333, exp: -7}, 1558163108144
+	case "m³", "m3":
+		factor, maxUnit = decimal{base: 1, exp: 12}, 9223372
+	case "cm³", "cm3", "cc":
+		factor, maxUnit = decimal{base: 1, exp: 6}, 9223372036854
+	case "mm³", "mm3":
+		factor, maxUnit = decimal{base: 1, exp: 3}, 9223372036854775
+	case "ft³", "ft3":
+		factor, maxUnit = decimal{base: 28316846592}, 325720309
+	case "in³", "in3":
+		factor, maxUnit = decimal{base: 16387064}, 562844694867
+	case "gal", "qt", "pt", "cup", "floz", "tbsp", "tsp":
+		return errors.New("ambiguous unit \"" + s[n:] + "\"; need US" + s[n:] + " or imp" + s[n:])
 	case "":
-		return noUnitErr("L")
+		return noUnitErr("L, USgal, impgal, m³ or ft³")
 	default:
 		if found := hasSuffixes(s[n:], "L"); found != "" {
-			return unknownUnitPrefixErr(found, "n,u,µ,m,k,M, or G")
+			return unknownUnitPrefixErr(found, nanoPrefixes)
+		}
+		if found := hasSuffixes(s[n:], volumeUnits...); found != "" {
+			return errors.New("\"" + found + "\" does not accept an SI prefix")
+		}
+		return incorrectUnitErr("L, USgal, impgal, m³ or ft³")
+	}
+	if si != unit {
+		return errors.New("\"" + s[n:] + "\" does not accept an SI prefix")
+	}
+	x, lossy, overflow := dtoiScaled(d, factor, si)
+	if lossy {
+		return errors.New("converting to nano litres would overflow, consider using nL for maximum precision")
+	}
+	if overflow {
+		if d.neg {
+			return minValueErr("-" + strconv.FormatInt(maxUnit, 10) + s[n:])
 		}
-		return incorrectUnitErr("L")
+		return maxValueErr(strconv.FormatInt(maxUnit, 10) + s[n:])
+	}
+	if roundsToZero(d, x) {
+		return resolutionErr(NanoLitre.String())
 	}
+	*v = Volume(x)
 	return nil
 }
 
+// volumeUnits are the units accepted by Volume.Set.
+var volumeUnits = []string{
+	"L",
+	"USgal", "USqt", "USpt", "UScup", "USfloz", "UStbsp", "UStsp",
+	"impgal", "impqt", "imppt", "impcup", "impfloz", "imptbsp", "imptsp",
+	"m³", "m3", "cc", "ft³", "ft3", "in³", "in3",
+}
+
+// MarshalJSON implements json.Marshaler. The Volume is encoded as a JSON string
+// of the text appended by AppendText, which UnmarshalJSON parses back to the
+// same value.
+func (v Volume) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v.MarshalText())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON string in
+// a format understood by Set or a JSON integer of nano litres.
+func (v *Volume) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, v.Set, func(x int64) error {
+		*v = Volume(x)
+		return nil
+	})
+}
+
+// AppendText implements encoding.TextAppender. It appends the Volume to b
+// exactly, without the rounding of String, so that Set parses the text back to
+// the same value. It does not allocate when b has enough capacity.
+func (v Volume) AppendText(b []byte) ([]byte, error) {
+	return append(appendSI(b, int64(v), nano, siFormat{mode: siExact}), 'L'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Volume is encoded as
+// appended by AppendText.
+func (v Volume) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is parsed by Set.
+func (v *Volume) UnmarshalText(text []byte) error {
+	return v.Set(string(text))
+}
+
+// Value implements driver.Valuer. The Volume is stored as an integer of nano
+// litres.
+func (v Volume) Value() (driver.Value, error) {
+	return int64(v), nil
+}
+
+// Scan implements sql.Scanner. It accepts either an integer of nano litres or
+// text in a format understood by Set.
+func (v *Volume) Scan(src any) error {
+	return scanValue(src, v.Set, func(x int64) error {
+		*v = Volume(x)
+		return nil
+	})
+}
+
 const (
 	NanoLitre  Volume = 1
 	MicroLitre Volume = 1000 * NanoLitre
@@ -77,7 +293,34 @@ const (
 	MegaLitre  Volume = 1000 * KiloLitre
 	GigaLitre  Volume = 1000 * MegaLitre
 
+	CubicMillimetre Volume = MicroLitre
 	CubicCentimetre Volume = MilliLitre
+	CubicMetre      Volume = KiloLitre
+
+	// US customary units of liquid volume, derived from the US gallon of 231
+	// cubic inches. Values that are not a whole number of nano litres are
+	// rounded.
+	USGallon     Volume = 3785411784 * NanoLitre
+	USQuart      Volume = USGallon / 4
+	USPint       Volume = USGallon / 8
+	USCup        Volume = 236588237 * NanoLitre
+	USFluidOunce Volume = 29573530 * NanoLitre
+	USTablespoon Volume = 14786765 * NanoLitre
+	USTeaspoon   Volume = 4928922 * NanoLitre
+
+	// Imperial units of volume, derived from the imperial gallon of exactly
+	// 4.54609L. The tablespoon is 5/8 and the teaspoon 5/24 of a fluid ounce.
+	ImperialGallon     Volume = 4546090 * MicroLitre
+	ImperialQuart      Volume = ImperialGallon / 4
+	ImperialPint       Volume = ImperialGallon / 8
+	ImperialCup        Volume = ImperialGallon / 16
+	ImperialFluidOunce Volume = 28413063 * NanoLitre
+	ImperialTablespoon Volume = 17758164 * NanoLitre
+	ImperialTeaspoon   Volume = 5919388 * NanoLitre
+
+	// Cubic imperial units.
+	CubicFoot Volume = 28316846592 * NanoLitre
+	CubicInch Volume = 16387064 * NanoLitre
 
 	maxVolume Volume = (1 << 63) - 1
 	minVolume Volume = -((1 << 63) - 1)
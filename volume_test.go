@@ -21,22 +21,62 @@ func TestVolume_Set(t *testing.T) {
 		{"1kL", KiloLitre},
 		{"1ML", MegaLitre},
 		{"1GL", GigaLitre},
+		{"1dL", 100 * MilliLitre},
+		{"33cL", 330 * MilliLitre},
+		{"1hL", 100 * Litre},
 		// Maximum and minimum values that are allowed.
 		{"9.223372036854775807GL", 9223372036854775807},
 		{"-9.223372036854775807GL", -9223372036854775807},
+		{"500pL", 1 * NanoLitre},
+		{"0pL", 0},
+		{"1USgal", USGallon},
+		{"-1USgal", -USGallon},
+		{"1USqt", USQuart},
+		{"1USpt", USPint},
+		{"1UScup", USCup},
+		{"2UScup", USPint},
+		{"1USfloz", USFluidOunce},
+		{"128USfloz", USGallon},
+		{"1UStbsp", USTablespoon},
+		{"1UStsp", USTeaspoon},
+		{"3UStsp", USTablespoon},
+		{"1impgal", ImperialGallon},
+		{"1impqt", ImperialQuart},
+		{"1imppt", ImperialPint},
+		{"1impcup", ImperialCup},
+		{"1impfloz", ImperialFluidOunce},
+		{"20impfloz", ImperialPint},
+		{"1imptbsp", ImperialTablespoon},
+		{"1imptsp", ImperialTeaspoon},
+		{"3imptsp", ImperialTablespoon},
+		{"1m³", CubicMetre},
+		{"1.5m3", 1500 * Litre},
+		{"1cm³", CubicCentimetre},
+		{"1cm3", CubicCentimetre},
+		{"250cc", 250 * MilliLitre},
+		{"1mm³", CubicMillimetre},
+		{"1mm3", CubicMillimetre},
+		{"1ft³", CubicFoot},
+		{"1ft3", CubicFoot},
+		{"1in³", CubicInch},
+		{"231in3", USGallon},
 	}
 
 	fails := []struct {
 		in  string
 		err string
 	}{
+		{
+			"1pL",
+			"nonzero value rounds to zero; resolution is 1nL",
+		},
 		{
 			"10EL",
-			"unknown unit prefix; valid prefixes for \"L\" are n,u,µ,m,k,M, or G",
+			"unknown unit prefix; valid prefixes for \"L\" are p,n,u,µ,m,c,d,da,h,k,M,G or T",
 		},
 		{
 			"10",
-			"no unit provided; need L",
+			"no unit provided; need L, USgal, impgal, m³ or ft³",
 		},
 		{
 			"9.224GL",
@@ -56,7 +96,7 @@ func TestVolume_Set(t *testing.T) {
 		},
 		{
 			"1random",
-			"unknown unit provided; need L",
+			"unknown unit provided; need L, USgal, impgal, m³ or ft³",
 		},
 		{
 			"L",
@@ -64,7 +104,7 @@ func TestVolume_Set(t *testing.T) {
 		},
 		{
 			"RPM",
-			"does not contain number or unit L",
+			"does not contain number or unit L, USgal, impgal, m³ or ft³",
 		},
 		{
 			"++1L",
@@ -82,6 +122,26 @@ func TestVolume_Set(t *testing.T) {
 			"1.1.1.1L",
 			"contains multiple decimal points",
 		},
+		{
+			"2436558000USgal",
+			"maximum value is 2436557120USgal",
+		},
+		{
+			"-9223373m³",
+			"minimum value is -9223372m³",
+		},
+		{
+			"1gal",
+			"ambiguous unit \"gal\"; need USgal or impgal",
+		},
+		{
+			"1pt",
+			"ambiguous unit \"pt\"; need USpt or imppt",
+		},
+		{
+			"1kUSgal",
+			"\"USgal\" does not accept an SI prefix",
+		},
 		{
 			string([]byte{0x33, 0x01}),
 			"unexpected end of string",